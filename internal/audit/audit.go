@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"context"
+	"log"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/repository"
+)
+
+const USER_AGENT_MAX_LENGTH = 512 // 저장할 User-Agent 최대 길이
+
+// clientInfoKey 타입은 요청한 클라이언트 정보를 담는 컨텍스트 키입니다.
+type clientInfoKey struct{}
+
+// clientInfo 구조체는 감사 로그에 함께 기록할 클라이언트 정보입니다.
+type clientInfo struct {
+	ip        string
+	userAgent string
+}
+
+var (
+	repositoryOnce  sync.Once
+	eventRepository repository.AuditEventRepository
+)
+
+// getRepository 함수는 감사 로그를 저장할 저장소를 반환합니다.
+func getRepository() repository.AuditEventRepository {
+	repositoryOnce.Do(func() {
+		eventRepository = repository.NewAuditEventRepository(database.GetDB())
+	})
+	return eventRepository
+}
+
+// WithClientInfo 함수는 요청한 클라이언트의 IP와 User-Agent를 컨텍스트에 추가합니다.
+// 서비스 계층에서 요청 객체 없이도 감사 로그에 접속 정보를 남길 수 있도록 합니다.
+func WithClientInfo(ctx context.Context, ip string, userAgent string) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, clientInfo{ip: ip, userAgent: userAgent})
+}
+
+// Record 함수는 사용자 본인이 수행한 작업의 감사 로그를 기록합니다. userID가 0이면 대상 사용자 없이 기록합니다.
+func Record(ctx context.Context, eventType string, userID int64, metadata map[string]string) {
+	event := &model.AuditEvent{
+		Type:     eventType,
+		Metadata: metadata,
+	}
+	if userID != 0 {
+		event.UserID = &userID
+	}
+	save(ctx, event)
+}
+
+// RecordByAdmin 함수는 관리자가 다른 사용자에게 수행한 작업의 감사 로그를 기록합니다.
+func RecordByAdmin(ctx context.Context, eventType string, actorID int64, userID int64, metadata map[string]string) {
+	event := &model.AuditEvent{
+		UserID:   &userID,
+		ActorID:  &actorID,
+		Type:     eventType,
+		Metadata: metadata,
+	}
+	save(ctx, event)
+}
+
+// save 함수는 컨텍스트의 클라이언트 정보를 채워 감사 로그를 저장합니다.
+// 감사 로그 저장 실패로 요청이 실패하지 않도록 오류는 로그로만 남기며, 클라이언트가 연결을 끊어도 기록은 계속합니다.
+func save(ctx context.Context, event *model.AuditEvent) {
+	if info, ok := ctx.Value(clientInfoKey{}).(clientInfo); ok {
+		event.IP = info.ip
+		event.UserAgent = truncate(info.userAgent, USER_AGENT_MAX_LENGTH)
+	}
+
+	if err := getRepository().CreateEvent(context.WithoutCancel(ctx), event); err != nil {
+		log.Printf("Failed to record audit event %s: %v", event.Type, err)
+	}
+}
+
+// truncate 함수는 문자열을 최대 글자 수까지 자릅니다.
+func truncate(value string, max int) string {
+	if utf8.RuneCountInString(value) <= max {
+		return value
+	}
+	return string([]rune(value)[:max])
+}
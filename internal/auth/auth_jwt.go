@@ -1,6 +1,9 @@
 package auth
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+
 	"github.com/jhphon0730/dairify/internal/config"
 	"github.com/jhphon0730/dairify/pkg/apperror"
 
@@ -9,50 +12,81 @@ import (
 	"time"
 )
 
-var (
-	jwtSecret []byte = []byte(config.GetConfig().JWT_SECRET)
+const (
+	ACCESS_TOKEN_TYPE       = "access"       // 액세스 토큰 타입
+	REFRESH_TOKEN_TYPE      = "refresh"      // 리프레시 토큰 타입
+	EMAIL_VERIFY_TOKEN_TYPE = "email_verify" // 이메일 인증 토큰 타입
+	MFA_PENDING_TOKEN_TYPE  = "mfa_pending"  // 2단계 인증 대기 토큰 타입
+
+	TOKEN_ID_BYTES = 16 // 토큰 ID(jti) 바이트 길이
 )
 
 type TokenClaims struct {
-	UserID int64 `json:"userID"`
+	UserID    int64  `json:"userID"`
+	TokenType string `json:"typ,omitempty"`
+	FamilyID  string `json:"fid,omitempty"`   // 리프레시 토큰 패밀리 ID
+	Email     string `json:"email,omitempty"` // 이메일 인증 대상 주소
 	jwt.RegisteredClaims
 }
 
-// GenerateJWTToken 함수는 사용자 ID를 기반으로 JWT 토큰을 생성합니다.
-func GenerateJWTToken(userID int64) (string, error) {
+// NewTokenID 함수는 토큰 ID(jti) 및 패밀리 ID로 사용할 무작위 문자열을 생성합니다.
+func NewTokenID() (string, error) {
+	b := make([]byte, TOKEN_ID_BYTES)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// signClaims 함수는 keyring의 active 키로 클레임에 서명합니다.
+func signClaims(claims TokenClaims) (string, error) {
+	keyring, err := LoadKeyring()
+	if err != nil {
+		return "", err
+	}
+	return keyring.Sign(claims)
+}
+
+// GenerateJWTToken 함수는 사용자 ID와 세션 ID를 기반으로 JWT 토큰을 생성합니다.
+// 세션 ID는 jti 클레임에 저장됩니다.
+func GenerateJWTToken(userID int64, sessionID string) (string, error) {
 	claims := TokenClaims{
-		UserID: userID,
+		UserID:    userID,
+		TokenType: ACCESS_TOKEN_TYPE,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        sessionID,
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * 1)), // 1시간 후 만료
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	return signClaims(claims)
 }
 
-// GenerateRefreshToken 함수는 사용자 ID를 기반으로 리프레시 토큰을 생성합니다.
-func GenerateRefreshToken(userID int64) (string, error) {
+// GenerateRefreshToken 함수는 사용자 ID, 패밀리 ID, 토큰 ID를 기반으로 리프레시 토큰을 생성합니다.
+func GenerateRefreshToken(userID int64, familyID string, tokenID string) (string, error) {
 	claims := TokenClaims{
-		UserID: userID,
+		UserID:    userID,
+		TokenType: REFRESH_TOKEN_TYPE,
+		FamilyID:  familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * 24 * 7)), // 7일 후 만료
+			ID:        tokenID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(config.GetConfig().Redis.RefreshTokenExpiry)), // 7일 후 만료
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	return signClaims(claims)
 }
 
 // ValidateAndParseJWT 함수는 JWT 토큰을 검증하고 파싱하여 클레임을 반환합니다.
 func ValidateAndParseJWT(tokenString string) (*TokenClaims, error) {
-	// 토큰을 파싱하고 클레임을 추출
-	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, apperror.ErrJWTInvalidTokenSigningMethod
-		}
-		return jwtSecret, nil
-	})
+	keyring, err := LoadKeyring()
+	if err != nil {
+		return nil, err
+	}
+
+	// 토큰을 파싱하고 클레임을 추출 (kid 헤더로 검증 키 선택)
+	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, keyring.Keyfunc,
+		jwt.WithValidMethods([]string{ALG_HS256, ALG_RS256, ALG_EDDSA}))
 	if err != nil {
 		return nil, err
 	}
@@ -68,3 +102,75 @@ func ValidateAndParseJWT(tokenString string) (*TokenClaims, error) {
 
 	return nil, apperror.ErrJWTInvalidRequest
 }
+
+// ValidateRefreshToken 함수는 리프레시 토큰을 검증하고 패밀리 정보가 포함된 클레임을 반환합니다.
+func ValidateRefreshToken(tokenString string) (*TokenClaims, error) {
+	claims, err := ValidateAndParseJWT(tokenString)
+	if err != nil {
+		return nil, apperror.ErrAuthInvalidRefreshToken
+	}
+
+	// 액세스 토큰이나 패밀리 정보가 없는 토큰은 리프레시에 사용할 수 없음
+	if claims.TokenType != REFRESH_TOKEN_TYPE || claims.FamilyID == "" || claims.ID == "" {
+		return nil, apperror.ErrAuthInvalidRefreshToken
+	}
+
+	return claims, nil
+}
+
+// GenerateEmailVerificationToken 함수는 사용자 ID와 이메일 주소를 서명한 이메일 인증 토큰을 생성합니다.
+func GenerateEmailVerificationToken(userID int64, email string) (string, error) {
+	claims := TokenClaims{
+		UserID:    userID,
+		TokenType: EMAIL_VERIFY_TOKEN_TYPE,
+		Email:     email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(config.GetConfig().EmailVerificationExpiry)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	return signClaims(claims)
+}
+
+// ValidateEmailVerificationToken 함수는 이메일 인증 토큰을 검증하고 클레임을 반환합니다.
+func ValidateEmailVerificationToken(tokenString string) (*TokenClaims, error) {
+	claims, err := ValidateAndParseJWT(tokenString)
+	if err != nil {
+		return nil, apperror.ErrUserEmailVerifyInvalidToken
+	}
+
+	if claims.TokenType != EMAIL_VERIFY_TOKEN_TYPE || claims.Email == "" {
+		return nil, apperror.ErrUserEmailVerifyInvalidToken
+	}
+
+	return claims, nil
+}
+
+// GenerateMFAPendingToken 함수는 비밀번호 확인을 통과한 사용자가 2단계 인증에 사용할 단기 토큰을 생성합니다.
+// 대기 ID는 jti 클레임에 저장됩니다.
+func GenerateMFAPendingToken(userID int64, pendingID string) (string, error) {
+	claims := TokenClaims{
+		UserID:    userID,
+		TokenType: MFA_PENDING_TOKEN_TYPE,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        pendingID,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(config.GetConfig().MFAPendingExpiry)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	return signClaims(claims)
+}
+
+// ValidateMFAPendingToken 함수는 2단계 인증 대기 토큰을 검증하고 클레임을 반환합니다.
+func ValidateMFAPendingToken(tokenString string) (*TokenClaims, error) {
+	claims, err := ValidateAndParseJWT(tokenString)
+	if err != nil {
+		return nil, apperror.ErrMFAInvalidToken
+	}
+
+	if claims.TokenType != MFA_PENDING_TOKEN_TYPE || claims.ID == "" {
+		return nil, apperror.ErrMFAInvalidToken
+	}
+
+	return claims, nil
+}
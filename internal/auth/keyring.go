@@ -0,0 +1,276 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	MIN_HMAC_SECRET_BYTES = 32   // HS256 비밀 키 최소 길이 (256bit)
+	MIN_RSA_KEY_BITS      = 2048 // RSA 키 최소 길이
+
+	KEY_FILE_SECRET_EXT = ".secret" // HS256 비밀 키 파일 확장자
+	KEY_FILE_PEM_EXT    = ".pem"    // RSA, Ed25519 키 파일 확장자 (개인 키 또는 공개 키)
+
+	ALG_HS256 = "HS256"
+	ALG_RS256 = "RS256"
+	ALG_EDDSA = "EdDSA"
+)
+
+// SigningKey 구조체는 keyring에 등록된 서명 키 하나를 나타냅니다.
+// 공개 키만 있는 키(signKey가 nil)는 검증에만 사용할 수 있습니다.
+type SigningKey struct {
+	ID        string
+	Algorithm string
+
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+// CanSign 함수는 키로 토큰을 서명할 수 있는지 확인합니다.
+func (k *SigningKey) CanSign() bool {
+	return k.signKey != nil
+}
+
+// signingMethod 함수는 키 알고리즘에 맞는 jwt 서명 방식을 반환합니다.
+func (k *SigningKey) signingMethod() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm)
+}
+
+// Keyring 구조체는 현재 서명에 사용하는 키(active)와 검증에만 사용하는 교체 중인 키(retiring)를 함께 보관합니다.
+// 키를 교체할 때 이전 키를 retiring으로 남겨두면 이미 발급된 토큰을 만료 전까지 계속 검증할 수 있습니다.
+type Keyring struct {
+	active *SigningKey
+	keys   map[string]*SigningKey
+	legacy *SigningKey // kid 헤더가 없는 기존 토큰을 검증할 키 (JWT_SECRET)
+}
+
+var (
+	keyring_once     sync.Once
+	keyring_instance *Keyring
+	keyring_err      error
+)
+
+// LoadKeyring 함수는 설정에서 서명 키를 읽어 keyring을 구성합니다. 서버 시작 시 호출하여 설정 오류를 미리 확인합니다.
+func LoadKeyring() (*Keyring, error) {
+	keyring_once.Do(func() {
+		keyring_instance, keyring_err = NewKeyring(config.GetConfig())
+	})
+	return keyring_instance, keyring_err
+}
+
+// NewKeyring 함수는 JWT_SECRET과 JWT_KEYS_DIR의 키 파일로 keyring을 생성합니다.
+// 비어 있거나 약한 키가 있으면 에러를 반환합니다.
+func NewKeyring(cfg *config.Config) (*Keyring, error) {
+	k := &Keyring{keys: map[string]*SigningKey{}}
+
+	if cfg.JWT_SECRET != "" {
+		key, err := newHMACKey(cfg.JWT_KID, []byte(cfg.JWT_SECRET))
+		if err != nil {
+			return nil, err
+		}
+		k.keys[key.ID] = key
+		k.legacy = key
+	}
+
+	if cfg.JWT_KEYS_DIR != "" {
+		if err := k.loadKeysDir(cfg.JWT_KEYS_DIR); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(k.keys) == 0 {
+		return nil, apperror.ErrJWTSecretRequired
+	}
+
+	activeKID := cfg.JWT_ACTIVE_KID
+	if activeKID == "" {
+		activeKID = cfg.JWT_KID
+	}
+	active, ok := k.keys[activeKID]
+	if !ok || !active.CanSign() {
+		return nil, apperror.ErrJWTActiveKeyNotFound
+	}
+	k.active = active
+
+	return k, nil
+}
+
+// loadKeysDir 함수는 디렉터리의 키 파일을 읽어 keyring에 추가합니다. 파일 이름(확장자 제외)이 kid가 됩니다.
+func (k *Keyring) loadKeysDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		ext := filepath.Ext(entry.Name())
+		kid := strings.TrimSuffix(entry.Name(), ext)
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return err
+		}
+
+		var key *SigningKey
+		switch ext {
+		case KEY_FILE_SECRET_EXT:
+			key, err = newHMACKey(kid, []byte(strings.TrimSpace(string(data))))
+		case KEY_FILE_PEM_EXT:
+			key, err = newPEMKey(kid, data)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if _, exists := k.keys[kid]; exists {
+			return apperror.ErrJWTDuplicateKeyID
+		}
+		k.keys[kid] = key
+	}
+
+	return nil
+}
+
+// newHMACKey 함수는 HS256 키를 생성합니다. 비밀 키가 너무 짧으면 에러를 반환합니다.
+func newHMACKey(kid string, secret []byte) (*SigningKey, error) {
+	if len(secret) < MIN_HMAC_SECRET_BYTES {
+		return nil, apperror.ErrJWTWeakSecret
+	}
+	return &SigningKey{ID: kid, Algorithm: ALG_HS256, signKey: secret, verifyKey: secret}, nil
+}
+
+// newPEMKey 함수는 PEM 형식의 RSA 또는 Ed25519 키로 서명 키를 생성합니다.
+// 개인 키이면 서명과 검증에, 공개 키이면 검증에만 사용합니다.
+func newPEMKey(kid string, data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, apperror.ErrJWTInvalidKeyFile
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, apperror.ErrJWTInvalidKeyFile
+	}
+	if err != nil {
+		return nil, apperror.ErrJWTInvalidKeyFile
+	}
+
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		if key.N.BitLen() < MIN_RSA_KEY_BITS {
+			return nil, apperror.ErrJWTWeakSecret
+		}
+		return &SigningKey{ID: kid, Algorithm: ALG_RS256, signKey: key, verifyKey: &key.PublicKey}, nil
+	case *rsa.PublicKey:
+		if key.N.BitLen() < MIN_RSA_KEY_BITS {
+			return nil, apperror.ErrJWTWeakSecret
+		}
+		return &SigningKey{ID: kid, Algorithm: ALG_RS256, verifyKey: key}, nil
+	case ed25519.PrivateKey:
+		return &SigningKey{ID: kid, Algorithm: ALG_EDDSA, signKey: key, verifyKey: key.Public()}, nil
+	case ed25519.PublicKey:
+		return &SigningKey{ID: kid, Algorithm: ALG_EDDSA, verifyKey: key}, nil
+	default:
+		return nil, apperror.ErrJWTInvalidKeyFile
+	}
+}
+
+// Sign 함수는 active 키로 클레임에 서명하고 kid 헤더를 추가합니다.
+func (k *Keyring) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(k.active.signingMethod(), claims)
+	token.Header["kid"] = k.active.ID
+	return token.SignedString(k.active.signKey)
+}
+
+// Keyfunc 함수는 토큰의 kid 헤더로 검증 키를 찾습니다. kid가 없는 기존 토큰은 JWT_SECRET 키로 검증합니다.
+// 토큰의 알고리즘이 키의 알고리즘과 다르면 거부하여 알고리즘 혼동 공격을 막습니다.
+func (k *Keyring) Keyfunc(token *jwt.Token) (interface{}, error) {
+	var key *SigningKey
+	if kid, ok := token.Header["kid"].(string); ok {
+		key = k.keys[kid]
+	} else {
+		key = k.legacy
+	}
+	if key == nil {
+		return nil, apperror.ErrJWTUnknownKeyID
+	}
+
+	if token.Method.Alg() != key.Algorithm {
+		return nil, apperror.ErrJWTInvalidTokenSigningMethod
+	}
+	return key.verifyKey, nil
+}
+
+// JWK 구조체는 RFC 7517 형식의 공개 키 하나를 나타냅니다.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+
+	N   string `json:"n,omitempty"`   // RSA modulus
+	E   string `json:"e,omitempty"`   // RSA exponent
+	Crv string `json:"crv,omitempty"` // OKP curve
+	X   string `json:"x,omitempty"`   // OKP public key
+}
+
+// JWKSet 구조체는 /.well-known/jwks.json 응답 형식입니다.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// PublicJWKS 함수는 keyring의 비대칭 키 공개 정보를 JWK Set으로 반환합니다.
+// HS256 비밀 키는 공개할 수 없으므로 포함하지 않습니다.
+func (k *Keyring) PublicJWKS() JWKSet {
+	set := JWKSet{Keys: []JWK{}}
+	for _, key := range k.keys {
+		var jwk JWK
+		switch pub := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwk = JWK{
+				KeyType: "RSA",
+				N:       base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+				E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+			}
+		case ed25519.PublicKey:
+			jwk = JWK{KeyType: "OKP", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(pub)}
+		default:
+			continue
+		}
+		jwk.KeyID = key.ID
+		jwk.Algorithm = key.Algorithm
+		jwk.Use = "sig"
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	// 응답이 항상 같은 순서가 되도록 kid 기준 정렬
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].KeyID < set.Keys[j].KeyID })
+	return set
+}
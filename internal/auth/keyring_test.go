@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testHMACSecret = "0123456789abcdef0123456789abcdef"
+
+// writeEd25519Key 함수는 디렉터리에 <kid>.pem 이름으로 Ed25519 개인 키(private) 또는 공개 키를 저장합니다.
+func writeEd25519Key(t *testing.T, dir string, kid string, private bool) ed25519.PublicKey {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var block *pem.Block
+	if private {
+		der, err := x509.MarshalPKCS8PrivateKey(priv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	} else {
+		der, err := x509.MarshalPKIXPublicKey(pub)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block = &pem.Block{Type: "PUBLIC KEY", Bytes: der}
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, kid+KEY_FILE_PEM_EXT), pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return pub
+}
+
+// newTestKeyring 함수는 HS256 기존 키(legacy)와 Ed25519 active 키(ed), 검증 전용 공개 키(old)로 keyring을 만듭니다.
+func newTestKeyring(t *testing.T) *Keyring {
+	t.Helper()
+
+	dir := t.TempDir()
+	writeEd25519Key(t, dir, "ed", true)
+	writeEd25519Key(t, dir, "old", false)
+
+	keyring, err := NewKeyring(&config.Config{
+		JWT_SECRET:     testHMACSecret,
+		JWT_KID:        "legacy",
+		JWT_KEYS_DIR:   dir,
+		JWT_ACTIVE_KID: "ed",
+	})
+	if err != nil {
+		t.Fatalf("NewKeyring() error = %v", err)
+	}
+	return keyring
+}
+
+func TestKeyringKeyfunc(t *testing.T) {
+	keyring := newTestKeyring(t)
+
+	tests := []struct {
+		name    string
+		header  map[string]interface{}
+		method  jwt.SigningMethod
+		wantKey interface{}
+		wantErr error
+	}{
+		{name: "kid로 키 선택", header: map[string]interface{}{"kid": "ed"}, method: jwt.SigningMethodEdDSA, wantKey: keyring.keys["ed"].verifyKey},
+		{name: "검증 전용 키", header: map[string]interface{}{"kid": "old"}, method: jwt.SigningMethodEdDSA, wantKey: keyring.keys["old"].verifyKey},
+		{name: "kid가 없으면 기존 키", header: map[string]interface{}{}, method: jwt.SigningMethodHS256, wantKey: keyring.legacy.verifyKey},
+		{name: "알 수 없는 kid", header: map[string]interface{}{"kid": "missing"}, method: jwt.SigningMethodEdDSA, wantErr: apperror.ErrJWTUnknownKeyID},
+		{name: "비대칭 키에 HS256 사용", header: map[string]interface{}{"kid": "ed"}, method: jwt.SigningMethodHS256, wantErr: apperror.ErrJWTInvalidTokenSigningMethod},
+		{name: "기존 키에 다른 알고리즘 사용", header: map[string]interface{}{}, method: jwt.SigningMethodEdDSA, wantErr: apperror.ErrJWTInvalidTokenSigningMethod},
+		{name: "kid가 문자열이 아니면 기존 키", header: map[string]interface{}{"kid": 1}, method: jwt.SigningMethodHS256, wantKey: keyring.legacy.verifyKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := keyring.Keyfunc(&jwt.Token{Header: tt.header, Method: tt.method})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Keyfunc() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !keysEqual(key, tt.wantKey) {
+				t.Errorf("Keyfunc() key = %v, want %v", key, tt.wantKey)
+			}
+		})
+	}
+}
+
+func TestKeyringSignAndVerify(t *testing.T) {
+	keyring := newTestKeyring(t)
+	claims := jwt.RegisteredClaims{Subject: "1"}
+
+	signed, err := keyring.Sign(claims)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	token, err := jwt.Parse(signed, keyring.Keyfunc)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if kid := token.Header["kid"]; kid != "ed" {
+		t.Errorf("kid = %v, want ed", kid)
+	}
+
+	// kid 헤더 없이 JWT_SECRET으로 서명된 기존 토큰도 검증
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testHMACSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := jwt.Parse(legacy, keyring.Keyfunc); err != nil {
+		t.Errorf("Parse(legacy) error = %v", err)
+	}
+}
+
+func TestKeyringWithoutLegacyKeyRejectsMissingKID(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Key(t, dir, "ed", true)
+
+	keyring, err := NewKeyring(&config.Config{JWT_KEYS_DIR: dir, JWT_ACTIVE_KID: "ed"})
+	if err != nil {
+		t.Fatalf("NewKeyring() error = %v", err)
+	}
+	if _, err := keyring.Keyfunc(&jwt.Token{Header: map[string]interface{}{}, Method: jwt.SigningMethodHS256}); !errors.Is(err, apperror.ErrJWTUnknownKeyID) {
+		t.Errorf("Keyfunc() error = %v, want %v", err, apperror.ErrJWTUnknownKeyID)
+	}
+}
+
+func TestNewKeyringErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Key(t, dir, "public", false)
+
+	tests := []struct {
+		name    string
+		cfg     *config.Config
+		wantErr error
+	}{
+		{name: "키 없음", cfg: &config.Config{}, wantErr: apperror.ErrJWTSecretRequired},
+		{name: "짧은 비밀 키", cfg: &config.Config{JWT_SECRET: "short", JWT_KID: "default"}, wantErr: apperror.ErrJWTWeakSecret},
+		{name: "없는 active 키", cfg: &config.Config{JWT_SECRET: testHMACSecret, JWT_KID: "default", JWT_ACTIVE_KID: "missing"}, wantErr: apperror.ErrJWTActiveKeyNotFound},
+		{name: "공개 키만 있는 active 키", cfg: &config.Config{JWT_KEYS_DIR: dir, JWT_ACTIVE_KID: "public"}, wantErr: apperror.ErrJWTActiveKeyNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewKeyring(tt.cfg); !errors.Is(err, tt.wantErr) {
+				t.Errorf("NewKeyring() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyringPublicJWKSExcludesHMAC(t *testing.T) {
+	keyring := newTestKeyring(t)
+
+	set := keyring.PublicJWKS()
+	if len(set.Keys) != 2 {
+		t.Fatalf("len(Keys) = %d, want 2", len(set.Keys))
+	}
+	for i, kid := range []string{"ed", "old"} {
+		if set.Keys[i].KeyID != kid || set.Keys[i].Algorithm != ALG_EDDSA {
+			t.Errorf("Keys[%d] = %+v, want kid %s with %s", i, set.Keys[i], kid, ALG_EDDSA)
+		}
+	}
+}
+
+// keysEqual 함수는 검증 키가 같은지 비교합니다. HS256 키는 바이트 슬라이스이므로 내용으로 비교합니다.
+func keysEqual(a interface{}, b interface{}) bool {
+	switch a := a.(type) {
+	case []byte:
+		b, ok := b.([]byte)
+		return ok && string(a) == string(b)
+	case ed25519.PublicKey:
+		b, ok := b.(ed25519.PublicKey)
+		return ok && a.Equal(b)
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"strings"
+
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+const (
+	PAT_PREFIX         = "dfy_pat_" // 개인 액세스 토큰 접두사 (JWT와 구분하고 유출 탐지에 사용)
+	PAT_DISPLAY_LENGTH = 12         // 목록에서 토큰을 구분하기 위해 보관하는 앞부분 길이
+)
+
+// GeneratePersonalAccessToken 함수는 새 개인 액세스 토큰 원문과 표시용 앞부분, 저장용 해시를 생성합니다.
+func GeneratePersonalAccessToken() (string, string, string, error) {
+	secret, err := utils.GenerateSecureToken()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	token := PAT_PREFIX + secret
+	return token, token[:PAT_DISPLAY_LENGTH], utils.HashToken(token), nil
+}
+
+// IsPersonalAccessToken 함수는 토큰 문자열이 개인 액세스 토큰 형식인지 확인합니다.
+func IsPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(token, PAT_PREFIX)
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+func TestGeneratePersonalAccessToken(t *testing.T) {
+	token, displayPrefix, tokenHash, err := GeneratePersonalAccessToken()
+	if err != nil {
+		t.Fatalf("GeneratePersonalAccessToken() error = %v", err)
+	}
+
+	if !strings.HasPrefix(token, PAT_PREFIX) {
+		t.Errorf("token = %q, want prefix %q", token, PAT_PREFIX)
+	}
+	if len(displayPrefix) != PAT_DISPLAY_LENGTH || !strings.HasPrefix(token, displayPrefix) {
+		t.Errorf("display prefix = %q, want first %d characters of token", displayPrefix, PAT_DISPLAY_LENGTH)
+	}
+	// 저장용 해시는 원문의 SHA-256(hex)이며 원문을 포함하지 않음
+	if tokenHash != utils.HashToken(token) || len(tokenHash) != 64 {
+		t.Errorf("hash = %q, want SHA-256 hex of token", tokenHash)
+	}
+	if strings.Contains(tokenHash, token[len(PAT_PREFIX):]) {
+		t.Errorf("hash contains token secret")
+	}
+
+	other, _, otherHash, err := GeneratePersonalAccessToken()
+	if err != nil {
+		t.Fatalf("GeneratePersonalAccessToken() error = %v", err)
+	}
+	if other == token || otherHash == tokenHash {
+		t.Errorf("two generated tokens are equal")
+	}
+}
+
+func TestHashTokenVector(t *testing.T) {
+	// SHA-256("abc") 테스트 벡터
+	const want = "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+	if got := utils.HashToken("abc"); got != want {
+		t.Errorf("HashToken(abc) = %s, want %s", got, want)
+	}
+}
+
+func TestIsPersonalAccessToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  bool
+	}{
+		{name: "개인 액세스 토큰", token: PAT_PREFIX + "abcdef", want: true},
+		{name: "접두사만", token: PAT_PREFIX, want: true},
+		{name: "JWT", token: "eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiIxIn0.sig", want: false},
+		{name: "대문자 접두사", token: strings.ToUpper(PAT_PREFIX) + "abcdef", want: false},
+		{name: "앞에 공백", token: " " + PAT_PREFIX + "abcdef", want: false},
+		{name: "빈 문자열", token: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPersonalAccessToken(tt.token); got != tt.want {
+				t.Errorf("IsPersonalAccessToken(%q) = %v, want %v", tt.token, got, tt.want)
+			}
+		})
+	}
+}
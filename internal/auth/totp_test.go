@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+// RFC 4226, RFC 6238 부록의 SHA-1 테스트 키 "12345678901234567890"의 base32 값
+const rfcTestSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestHOTPRFC4226Vectors(t *testing.T) {
+	// RFC 4226 부록 D의 6자리 코드
+	want := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+
+	key := []byte("12345678901234567890")
+	for counter, code := range want {
+		if got := hotp(key, int64(counter)); got != code {
+			t.Errorf("hotp(counter=%d) = %s, want %s", counter, got, code)
+		}
+	}
+}
+
+func TestValidateTOTPCodeRFC6238Vectors(t *testing.T) {
+	// RFC 6238 부록 B의 SHA-1 8자리 코드 중 뒤 6자리
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1111111111, code: "050471"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+		{unix: 20000000000, code: "353130"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			counter, ok := ValidateTOTPCode(rfcTestSecret, tt.code, time.Unix(tt.unix, 0))
+			if !ok {
+				t.Fatalf("ValidateTOTPCode(%s, t=%d) = false, want true", tt.code, tt.unix)
+			}
+			if want := tt.unix / TOTP_PERIOD; counter != want {
+				t.Errorf("counter = %d, want %d", counter, want)
+			}
+		})
+	}
+}
+
+func TestValidateTOTPCodeSkew(t *testing.T) {
+	issuedAt := time.Unix(1111111111, 0)
+	const code = "050471"
+	issuedCounter := issuedAt.Unix() / TOTP_PERIOD
+
+	tests := []struct {
+		name   string
+		now    time.Time
+		wantOK bool
+	}{
+		{name: "같은 주기", now: issuedAt, wantOK: true},
+		{name: "한 주기 뒤", now: issuedAt.Add(TOTP_PERIOD * time.Second), wantOK: true},
+		{name: "한 주기 앞", now: issuedAt.Add(-TOTP_PERIOD * time.Second), wantOK: true},
+		{name: "두 주기 뒤", now: issuedAt.Add(2 * TOTP_PERIOD * time.Second), wantOK: false},
+		{name: "두 주기 앞", now: issuedAt.Add(-2 * TOTP_PERIOD * time.Second), wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter, ok := ValidateTOTPCode(rfcTestSecret, code, tt.now)
+			if ok != tt.wantOK {
+				t.Fatalf("ValidateTOTPCode() ok = %v, want %v", ok, tt.wantOK)
+			}
+			// 허용 오차 안에서 일치하면 현재 주기가 아닌 코드가 만들어진 주기를 반환
+			if ok && counter != issuedCounter {
+				t.Errorf("counter = %d, want %d", counter, issuedCounter)
+			}
+		})
+	}
+}
+
+func TestValidateTOTPCodeInvalidInput(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+		wantOK bool
+	}{
+		{name: "앞뒤 공백", secret: rfcTestSecret, code: " 050471 ", wantOK: true},
+		{name: "소문자 비밀 키", secret: "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", code: "050471", wantOK: true},
+		{name: "틀린 코드", secret: rfcTestSecret, code: "050472", wantOK: false},
+		{name: "짧은 코드", secret: rfcTestSecret, code: "50471", wantOK: false},
+		{name: "8자리 코드", secret: rfcTestSecret, code: "07081804", wantOK: false},
+		{name: "잘못된 비밀 키", secret: "not-base32!", code: "050471", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := ValidateTOTPCode(tt.secret, tt.code, now); ok != tt.wantOK {
+				t.Errorf("ValidateTOTPCode(%q, %q) ok = %v, want %v", tt.secret, tt.code, ok, tt.wantOK)
+			}
+		})
+	}
+}
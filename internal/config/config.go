@@ -1,7 +1,10 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,21 +28,110 @@ type Redis struct {
 	Port     string
 	Password string
 
-	USER_DB           int
-	AccessTokenExpiry time.Duration
+	USER_DB            int
+	AccessTokenExpiry  time.Duration
+	RefreshTokenExpiry time.Duration
 }
 
+// Mail 구조체는 메일 발송 설정 정보를 포함합니다.
+type Mail struct {
+	Driver   string // smtp 또는 log
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	LogDir   string // log 드라이버 사용 시 메일을 저장할 디렉터리
+}
+
+// PasswordHash 구조체는 비밀번호 해시 알고리즘과 비용 설정을 포함합니다.
+type PasswordHash struct {
+	Algorithm string // argon2id 또는 bcrypt (새 해시 생성 시 사용)
+
+	BcryptCost int
+
+	Argon2Memory      uint32 // KiB
+	Argon2Iterations  uint32
+	Argon2Parallelism uint8
+}
+
+// OIDCProvider 구조체는 외부 OpenID Connect 로그인 제공자 하나의 설정 정보를 포함합니다.
+// AuthURL, TokenURL, JWKSURL을 모두 지정하지 않으면 Issuer의 discovery 문서에서 읽습니다.
+type OIDCProvider struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string // 제공자가 인가 코드를 전달할 프론트엔드 주소
+	Scopes       []string
+
+	AuthURL  string
+	TokenURL string
+	JWKSURL  string
+}
+
+// 비밀번호 해시 알고리즘
+const (
+	PASSWORD_HASH_ARGON2ID = "argon2id"
+	PASSWORD_HASH_BCRYPT   = "bcrypt"
+)
+
+// 이메일 인증 정책
+const (
+	EMAIL_VERIFICATION_OFF       = "off"       // 인증 여부와 관계없이 모든 기능 허용
+	EMAIL_VERIFICATION_REQUIRED  = "required"  // 미인증 계정은 로그인 불가
+	EMAIL_VERIFICATION_READ_ONLY = "read_only" // 미인증 계정은 읽기 전용으로 로그인
+)
+
 // Config 구조체는 애플리케이션의 설정 정보를 포함합니다.
 type Config struct {
 	AppEnv string
 	Port   string
 
-	BCRYPT_COST string
-	JWT_SECRET  string
-	CHAR_SET    string
+	TRUST_PROXY  bool   // X-Forwarded-For 등 프록시 헤더 신뢰 여부
+	APP_BASE_URL string // 메일 본문 링크에 사용할 프론트엔드 주소
+
+	DEFAULT_TIMEZONE string // 시간대를 설정하지 않은 사용자의 날짜 계산에 사용할 IANA 시간대
+
+	PasswordResetExpiry time.Duration
+
+	EMAIL_VERIFICATION        string // off, required, read_only
+	EmailVerificationExpiry   time.Duration
+	EmailVerificationCooldown time.Duration // 인증 메일 재발송 최소 간격
+
+	MFAPendingExpiry     time.Duration // 비밀번호 확인 후 2단계 인증까지 허용하는 시간
+	MFAMaxAttempts       int           // 2단계 인증 대기 토큰 하나당 허용하는 코드 입력 횟수
+	MFARecoveryCodeCount int           // 발급할 복구 코드 개수
+
+	SigninFailureWindow          time.Duration // 로그인 실패 횟수 집계 구간
+	SigninMaxFailuresPerUsername int64         // 아이디별 잠금 전 허용 실패 횟수
+	SigninMaxFailuresPerIP       int64         // IP별 잠금 전 허용 실패 횟수
+	SigninLockoutBase            time.Duration // 첫 잠금 시간 (연속 잠금마다 두 배씩 증가)
+	SigninLockoutMax             time.Duration // 최대 잠금 시간
+	SigninLockoutLevelExpiry     time.Duration // 연속 잠금 횟수 유지 기간
+
+	// OIDC_PROVIDERS에 나열된 이름별로 OIDC_<NAME>_ISSUER, OIDC_<NAME>_CLIENT_ID 등의 환경 변수를 읽습니다.
+	OIDCProviders   map[string]OIDCProvider
+	OIDCStateExpiry time.Duration // 외부 로그인 시작 후 콜백까지 허용하는 시간
+
+	AccountDeletionGracePeriod time.Duration // 계정 삭제 요청 후 실제 삭제까지의 유예 기간
+	AccountDeletionJobInterval time.Duration // 삭제 예정 계정 정리 작업 실행 간격
 
-	Postgres Postgres
-	Redis    Redis
+	DiaryTrashRetention   time.Duration // 삭제한 일기를 휴지통에 보관하는 기간 ( 이후 영구 삭제 )
+	DiaryPurgeJobInterval time.Duration // 보관 기간이 지난 일기 영구 삭제 작업 실행 간격
+
+	JWT_SECRET string
+	JWT_KID    string // JWT_SECRET 키의 kid
+	// JWT_KEYS_DIR 디렉터리의 <kid>.secret(HS256), <kid>.pem(RS256, EdDSA) 파일을 추가로 읽으며,
+	// JWT_ACTIVE_KID 키로 서명하고 나머지 키는 검증에만 사용합니다.
+	JWT_KEYS_DIR   string
+	JWT_ACTIVE_KID string
+	CHAR_SET       string
+
+	Postgres     Postgres
+	Redis        Redis
+	Mail         Mail
+	PasswordHash PasswordHash
 }
 
 var (
@@ -59,7 +151,36 @@ func LoadConfig() (*Config, error) {
 		AppEnv: getEnv("APP_ENV", "development"),
 		Port:   getEnv("PORT", "8080"),
 
-		BCRYPT_COST: getEnv("BCRYPT_COST", "5"),
+		TRUST_PROXY:  getEnv("TRUST_PROXY", "false") == "true",
+		APP_BASE_URL: getEnv("APP_BASE_URL", "http://localhost:5173"),
+
+		DEFAULT_TIMEZONE: getEnv("DEFAULT_TIMEZONE", getEnv("TIMEZONE", "Asia/Shanghai")),
+
+		PasswordResetExpiry: time.Minute * 30,
+
+		EMAIL_VERIFICATION:        getEnv("EMAIL_VERIFICATION", EMAIL_VERIFICATION_OFF),
+		EmailVerificationExpiry:   time.Hour * 24,
+		EmailVerificationCooldown: time.Minute,
+
+		MFAPendingExpiry:     time.Minute * 5,
+		MFAMaxAttempts:       5,
+		MFARecoveryCodeCount: 10,
+
+		SigninFailureWindow:          time.Minute * 15,
+		SigninMaxFailuresPerUsername: 5,
+		SigninMaxFailuresPerIP:       20,
+		SigninLockoutBase:            time.Minute,
+		SigninLockoutMax:             time.Hour,
+		SigninLockoutLevelExpiry:     time.Hour * 24,
+
+		OIDCProviders:   loadOIDCProviders(),
+		OIDCStateExpiry: time.Minute * 10,
+
+		AccountDeletionGracePeriod: time.Hour * 24 * time.Duration(getEnvInt("ACCOUNT_DELETION_GRACE_DAYS", 14)),
+		AccountDeletionJobInterval: time.Hour,
+
+		DiaryTrashRetention:   time.Hour * 24 * time.Duration(getEnvInt("DIARY_TRASH_RETENTION_DAYS", 30)),
+		DiaryPurgeJobInterval: time.Hour,
 
 		Postgres: Postgres{
 			DB_HOST:     getEnv("DB_HOST", "localhost"),
@@ -71,14 +192,34 @@ func LoadConfig() (*Config, error) {
 			TIMEZONE:    getEnv("TIMEZONE", "Asia/Shanghai"),
 		},
 		Redis: Redis{
-			Host:              getEnv("REDIS_HOST", "localhost"),
-			Port:              getEnv("REDIS_PORT", "6379"),
-			Password:          getEnv("REDIS_PASSWORD", ""),
-			USER_DB:           0,
-			AccessTokenExpiry: time.Hour,
+			Host:               getEnv("REDIS_HOST", "localhost"),
+			Port:               getEnv("REDIS_PORT", "6379"),
+			Password:           getEnv("REDIS_PASSWORD", ""),
+			USER_DB:            0,
+			AccessTokenExpiry:  time.Hour,
+			RefreshTokenExpiry: time.Hour * 24 * 7,
+		},
+		Mail: Mail{
+			Driver:   getEnv("MAIL_DRIVER", "log"),
+			Host:     getEnv("SMTP_HOST", "localhost"),
+			Port:     getEnv("SMTP_PORT", "587"),
+			Username: getEnv("SMTP_USERNAME", ""),
+			Password: getEnv("SMTP_PASSWORD", ""),
+			From:     getEnv("MAIL_FROM", "no-reply@dairify.local"),
+			LogDir:   getEnv("MAIL_LOG_DIR", "./mail"),
 		},
-		JWT_SECRET: getEnv("JWT_SECRET", ""),
-		CHAR_SET:   getEnv("CHAR_SET", "asdqwe123"),
+		PasswordHash: PasswordHash{
+			Algorithm:         getEnv("PASSWORD_HASH_ALGORITHM", PASSWORD_HASH_ARGON2ID),
+			BcryptCost:        getEnvInt("BCRYPT_COST", 12),
+			Argon2Memory:      uint32(getEnvInt("ARGON2_MEMORY_KB", 64*1024)),
+			Argon2Iterations:  uint32(getEnvInt("ARGON2_ITERATIONS", 3)),
+			Argon2Parallelism: uint8(getEnvInt("ARGON2_PARALLELISM", 2)),
+		},
+		JWT_SECRET:     getEnv("JWT_SECRET", ""),
+		JWT_KID:        getEnv("JWT_KID", "default"),
+		JWT_KEYS_DIR:   getEnv("JWT_KEYS_DIR", ""),
+		JWT_ACTIVE_KID: getEnv("JWT_ACTIVE_KID", ""),
+		CHAR_SET:       getEnv("CHAR_SET", "asdqwe123"),
 	}, nil
 }
 
@@ -90,6 +231,16 @@ func GetConfig() *Config {
 	return configInstance
 }
 
+// ValidateEmailVerification 함수는 이메일 인증 정책이 off, required, read_only 중 하나인지 확인합니다.
+// 알 수 없는 값은 어느 정책에도 해당하지 않아 미인증 계정에 모든 기능이 허용되므로 서버 시작 시 거부합니다.
+func (c *Config) ValidateEmailVerification() error {
+	switch c.EMAIL_VERIFICATION {
+	case EMAIL_VERIFICATION_OFF, EMAIL_VERIFICATION_REQUIRED, EMAIL_VERIFICATION_READ_ONLY:
+		return nil
+	}
+	return fmt.Errorf("EMAIL_VERIFICATION must be one of %s, %s, %s: %q", EMAIL_VERIFICATION_OFF, EMAIL_VERIFICATION_REQUIRED, EMAIL_VERIFICATION_READ_ONLY, c.EMAIL_VERIFICATION)
+}
+
 // getEnv 함수는 환경 변수에서 값을 가져오고, 없으면 기본값을 반환합니다.
 func getEnv(key string, defaultValue string) string {
 	if value, exists := os.LookupEnv(key); exists {
@@ -97,3 +248,37 @@ func getEnv(key string, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvInt 함수는 환경 변수에서 정수 값을 가져오고, 없거나 올바르지 않으면 기본값을 반환합니다.
+func getEnvInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(getEnv(key, ""))
+	if err != nil || value < 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// loadOIDCProviders 함수는 OIDC_PROVIDERS(쉼표로 구분)에 나열된 외부 로그인 제공자 설정을 읽습니다.
+func loadOIDCProviders() map[string]OIDCProvider {
+	providers := map[string]OIDCProvider{}
+	for _, name := range strings.Split(getEnv("OIDC_PROVIDERS", ""), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		providers[name] = OIDCProvider{
+			Name:         name,
+			Issuer:       strings.TrimSuffix(getEnv(prefix+"ISSUER", ""), "/"),
+			ClientID:     getEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret: getEnv(prefix+"CLIENT_SECRET", ""),
+			RedirectURL:  getEnv(prefix+"REDIRECT_URL", ""),
+			Scopes:       strings.Fields(getEnv(prefix+"SCOPES", "openid email profile")),
+			AuthURL:      getEnv(prefix+"AUTH_URL", ""),
+			TokenURL:     getEnv(prefix+"TOKEN_URL", ""),
+			JWKSURL:      getEnv(prefix+"JWKS_URL", ""),
+		}
+	}
+	return providers
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/jhphon0730/dairify/internal/model"
+)
+
+// AccountDeleteDTO 구조체는 계정 삭제 요청을 위한 데이터 전송 객체입니다.
+// 비밀번호가 없는 외부 로그인 계정은 비밀번호 없이 삭제를 예약하므로, 비밀번호 필수 여부는 서비스에서 확인합니다.
+type AccountDeleteDTO struct {
+	Password string `json:"password"`
+}
+
+// Validate 함수는 계정 삭제 입력 값을 확인해주는 함수입니다.
+func (d *AccountDeleteDTO) Validate() error {
+	return nil
+}
+
+// AccountDeleteResponseDTO 구조체는 계정 삭제 예약 응답을 위한 데이터 전송 객체입니다.
+type AccountDeleteResponseDTO struct {
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at"`
+}
+
+// AccountExportDTO 구조체는 사용자가 소유한 모든 데이터를 내보내기 위한 데이터 전송 객체입니다.
+type AccountExportDTO struct {
+	ExportedAt time.Time        `json:"exported_at"`
+	User       *model.User      `json:"user"`
+	Categories []model.Category `json:"categories"`
+	Diaries    []model.Diary    `json:"diaries"` // 휴지통의 일기와 이미지 정보 포함
+}
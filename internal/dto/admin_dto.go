@@ -0,0 +1,139 @@
+package dto
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+const (
+	ADMIN_USER_LIST_DEFAULT_LIMIT = 20  // 사용자 목록 기본 페이지 크기
+	ADMIN_USER_LIST_MAX_LIMIT     = 100 // 사용자 목록 최대 페이지 크기
+	ADMIN_USER_SEARCH_MAX_LENGTH  = 100 // 검색어 최대 길이
+
+	ADMIN_SIGNIN_LOCKOUT_LIST_DEFAULT_LIMIT = 20  // 로그인 잠금 기록 목록 기본 페이지 크기
+	ADMIN_SIGNIN_LOCKOUT_LIST_MAX_LIMIT     = 100 // 로그인 잠금 기록 목록 최대 페이지 크기
+	ADMIN_SIGNIN_LOCKOUT_IDENTIFIER_MAX_LEN = 255 // 잠금 식별자(아이디, IP) 최대 길이
+)
+
+// AdminListUsersDTO 구조체는 관리자 사용자 목록 조회 및 검색 요청 DTO입니다.
+// Query는 아이디, 닉네임, 이메일의 부분 일치로 검색합니다.
+type AdminListUsersDTO struct {
+	Query  string
+	Status string
+	Page   int
+	Limit  int
+}
+
+// Validate 함수는 AdminListUsersDTO의 입력 유효성을 검사하고 생략된 값에 기본값을 채웁니다.
+func (d *AdminListUsersDTO) Validate() error {
+	d.Query = strings.TrimSpace(d.Query)
+	if utf8.RuneCountInString(d.Query) > ADMIN_USER_SEARCH_MAX_LENGTH {
+		return apperror.ErrAdminSearchQueryTooLong
+	}
+
+	if d.Status == "" {
+		d.Status = model.ADMIN_USER_STATUS_ALL
+	}
+	if d.Status != model.ADMIN_USER_STATUS_ALL && d.Status != model.ADMIN_USER_STATUS_ACTIVE && d.Status != model.ADMIN_USER_STATUS_DISABLED {
+		return apperror.ErrAdminInvalidStatusFilter
+	}
+
+	if d.Page == 0 {
+		d.Page = 1
+	}
+	if d.Page < 1 {
+		return apperror.ErrHttpInvalidPage
+	}
+
+	if d.Limit == 0 {
+		d.Limit = ADMIN_USER_LIST_DEFAULT_LIMIT
+	}
+	if d.Limit < 1 || d.Limit > ADMIN_USER_LIST_MAX_LIMIT {
+		return apperror.ErrHttpInvalidLimit
+	}
+
+	return nil
+}
+
+// Offset 함수는 페이지 번호와 크기로 건너뛸 행 수를 계산합니다.
+func (d *AdminListUsersDTO) Offset() int {
+	return (d.Page - 1) * d.Limit
+}
+
+// AdminListUsersResponseDTO 구조체는 관리자 사용자 목록 조회 응답 DTO입니다.
+type AdminListUsersResponseDTO struct {
+	Users []*model.AdminUserSummary `json:"users"`
+	Total int64                     `json:"total"`
+	Page  int                       `json:"page"`
+	Limit int                       `json:"limit"`
+}
+
+// AdminUserResponseDTO 구조체는 관리자 사용자 단건 조회 및 상태 변경 응답 DTO입니다.
+type AdminUserResponseDTO struct {
+	User *model.AdminUserSummary `json:"user"`
+}
+
+// AdminRevokeSessionsResponseDTO 구조체는 관리자 세션 강제 폐기 응답 DTO입니다.
+type AdminRevokeSessionsResponseDTO struct {
+	RevokedCount int `json:"revoked_count"`
+}
+
+// AdminListSigninLockoutsDTO 구조체는 관리자 로그인 잠금 기록 조회 요청 DTO입니다.
+// Active가 true이면 아직 잠금 시간이 지나지 않은 기록만 조회합니다.
+type AdminListSigninLockoutsDTO struct {
+	Scope      string
+	Identifier string
+	UserID     *int64
+	Active     bool
+	Page       int
+	Limit      int
+}
+
+// Validate 함수는 AdminListSigninLockoutsDTO의 입력 유효성을 검사하고 생략된 값에 기본값을 채웁니다.
+func (d *AdminListSigninLockoutsDTO) Validate() error {
+	if d.Scope != "" && d.Scope != model.SIGNIN_LOCKOUT_SCOPE_USERNAME && d.Scope != model.SIGNIN_LOCKOUT_SCOPE_IP {
+		return apperror.ErrAdminInvalidLockoutScope
+	}
+
+	// 아이디 잠금은 정규화된 아이디로 기록되므로 같은 규칙으로 맞춤
+	d.Identifier = strings.ToLower(strings.TrimSpace(d.Identifier))
+	if utf8.RuneCountInString(d.Identifier) > ADMIN_SIGNIN_LOCKOUT_IDENTIFIER_MAX_LEN {
+		return apperror.ErrAdminIdentifierTooLong
+	}
+
+	if d.UserID != nil && *d.UserID < 1 {
+		return apperror.ErrAdminInvalidUserID
+	}
+
+	if d.Page == 0 {
+		d.Page = 1
+	}
+	if d.Page < 1 {
+		return apperror.ErrHttpInvalidPage
+	}
+
+	if d.Limit == 0 {
+		d.Limit = ADMIN_SIGNIN_LOCKOUT_LIST_DEFAULT_LIMIT
+	}
+	if d.Limit < 1 || d.Limit > ADMIN_SIGNIN_LOCKOUT_LIST_MAX_LIMIT {
+		return apperror.ErrHttpInvalidLimit
+	}
+
+	return nil
+}
+
+// Offset 함수는 페이지 번호와 크기로 건너뛸 행 수를 계산합니다.
+func (d *AdminListSigninLockoutsDTO) Offset() int {
+	return (d.Page - 1) * d.Limit
+}
+
+// AdminListSigninLockoutsResponseDTO 구조체는 관리자 로그인 잠금 기록 조회 응답 DTO입니다.
+type AdminListSigninLockoutsResponseDTO struct {
+	Lockouts []*model.SigninLockout `json:"lockouts"`
+	Total    int64                  `json:"total"`
+	Page     int                    `json:"page"`
+	Limit    int                    `json:"limit"`
+}
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+const (
+	AUDIT_EVENT_LIST_DEFAULT_LIMIT = 20  // 보안 기록 목록 기본 페이지 크기
+	AUDIT_EVENT_LIST_MAX_LIMIT     = 100 // 보안 기록 목록 최대 페이지 크기
+	AUDIT_EVENT_TYPE_MAX_LENGTH    = 50  // 이벤트 종류 최대 길이
+)
+
+// AuditEventListDTO 구조체는 보안 기록 목록 조회 요청 DTO입니다.
+// UserID가 nil이면 전체 사용자의 기록을 조회합니다. (관리자 전용)
+type AuditEventListDTO struct {
+	UserID *int64
+	Type   string
+	Page   int
+	Limit  int
+}
+
+// Validate 함수는 AuditEventListDTO의 입력 유효성을 검사하고 생략된 값에 기본값을 채웁니다.
+func (d *AuditEventListDTO) Validate() error {
+	if d.UserID != nil && *d.UserID < 1 {
+		return apperror.ErrAuditInvalidUserID
+	}
+
+	d.Type = strings.TrimSpace(d.Type)
+	if len(d.Type) > AUDIT_EVENT_TYPE_MAX_LENGTH {
+		return apperror.ErrAuditInvalidEventType
+	}
+
+	if d.Page == 0 {
+		d.Page = 1
+	}
+	if d.Page < 1 {
+		return apperror.ErrHttpInvalidPage
+	}
+
+	if d.Limit == 0 {
+		d.Limit = AUDIT_EVENT_LIST_DEFAULT_LIMIT
+	}
+	if d.Limit < 1 || d.Limit > AUDIT_EVENT_LIST_MAX_LIMIT {
+		return apperror.ErrHttpInvalidLimit
+	}
+
+	return nil
+}
+
+// Offset 함수는 페이지 번호와 크기로 건너뛸 행 수를 계산합니다.
+func (d *AuditEventListDTO) Offset() int {
+	return (d.Page - 1) * d.Limit
+}
+
+// AuditEventListResponseDTO 구조체는 보안 기록 목록 조회 응답 DTO입니다.
+type AuditEventListResponseDTO struct {
+	Events []*model.AuditEvent `json:"events"`
+	Total  int64               `json:"total"`
+	Page   int                 `json:"page"`
+	Limit  int                 `json:"limit"`
+}
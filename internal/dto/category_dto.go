@@ -39,6 +39,7 @@ type CreateCategoryResponseDTO struct {
 // GetCategoriesResponseDTO 구조체는 카테고리 목록 조회 응답을 위한 데이터 전송 객체입니다.
 type GetCategoriesResponseDTO struct {
 	Categories []model.Category `json:"categories"`
+	CursorPageInfoDTO
 }
 
 // UpdateCategoryDTO 구조체는 카테고리 이름 업데이트를 위한 데이터 전송 객체입니다.
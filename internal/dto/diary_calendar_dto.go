@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// DiaryCalendarDTO 구조체는 월간 캘린더 조회 요청 DTO입니다.
+// Year, Month를 모두 생략하면 사용자 시간대 기준 이번 달을 조회합니다.
+type DiaryCalendarDTO struct {
+	Year  int
+	Month int
+
+	// Location은 날짜를 계산할 사용자 시간대입니다. nil이면 UTC를 사용합니다.
+	Location *time.Location
+}
+
+// Validate 함수는 DiaryCalendarDTO의 입력 유효성을 검사하고 생략된 값에 기본값을 채웁니다.
+func (d *DiaryCalendarDTO) Validate() error {
+	if d.Location == nil {
+		d.Location = time.UTC
+	}
+
+	if d.Year == 0 && d.Month == 0 {
+		now := time.Now().In(d.Location)
+		d.Year, d.Month = now.Year(), int(now.Month())
+	}
+	if d.Year < 1 || d.Year > 9999 {
+		return apperror.ErrDiaryCalendarInvalidYear
+	}
+	if d.Month < 1 || d.Month > 12 {
+		return apperror.ErrDiaryCalendarInvalidMonth
+	}
+
+	return nil
+}
+
+// Range 함수는 조회할 달의 시작 시각(1일 0시)과 다음 달 시작 시각을 사용자 시간대 기준으로 반환합니다.
+func (d *DiaryCalendarDTO) Range() (time.Time, time.Time) {
+	start := time.Date(d.Year, time.Month(d.Month), 1, 0, 0, 0, 0, d.Location)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// DiaryCalendarResponseDTO 구조체는 월간 캘린더 조회 응답 DTO입니다. Days에는 해당 달의 모든 날짜가 순서대로 포함됩니다.
+type DiaryCalendarResponseDTO struct {
+	Year     int                       `json:"year"`
+	Month    int                       `json:"month"`
+	Timezone string                    `json:"timezone"`
+	Days     []*model.DiaryCalendarDay `json:"days"`
+}
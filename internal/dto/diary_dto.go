@@ -1,25 +1,147 @@
 package dto
 
 import (
+	"errors"
 	"strings"
+	"time"
 
 	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/searchquery"
 	"github.com/jhphon0730/dairify/pkg/apperror"
 )
 
+// DiaryListFilterDTO 구조체는 일기 목록 조회 필터 DTO입니다.
+// Query는 검색식(searchquery 문법)이며 category_id, title, mood, tags, from, to 필터와 함께 AND로 결합됩니다.
+// From, To(YYYY-MM-DD)는 사용자 시간대의 날짜이며 두 날짜 모두 포함합니다. Moods는 그중 하나와 일치하는 일기를 조회합니다.
+// Tags는 TagMode가 all이면 모든 태그가, any이면 하나 이상의 태그가 지정된 일기를 조회합니다.
+type DiaryListFilterDTO struct {
+	CategoryID *int64
+	Title      string
+	Moods      []string
+	Tags       []string
+	TagMode    string
+	From       string
+	To         string
+	Query      string
+
+	// Location은 날짜를 해석할 사용자 시간대입니다. nil이면 UTC를 사용합니다.
+	Location *time.Location
+
+	// Validate에서 계산되는 값입니다. FromTime 이상, ToTime 미만의 작성 시각을 조회하며, 생략된 날짜는 nil입니다.
+	FromTime *time.Time
+	ToTime   *time.Time
+	Search   *searchquery.Query
+}
+
+// parseDateRange 함수는 시작, 종료 날짜(YYYY-MM-DD)를 사용자 시간대 기준 [시작일 0시, 종료일 다음 날 0시) 구간으로 변환합니다.
+// 생략된 날짜는 nil을 반환합니다.
+func parseDateRange(from string, to string, location *time.Location) (*time.Time, *time.Time, error) {
+	var fromTime, toTime *time.Time
+	if from != "" {
+		parsed, err := time.ParseInLocation(searchquery.DATE_LAYOUT, from, location)
+		if err != nil {
+			return nil, nil, apperror.ErrDiaryInvalidDate
+		}
+		fromTime = &parsed
+	}
+	if to != "" {
+		parsed, err := time.ParseInLocation(searchquery.DATE_LAYOUT, to, location)
+		if err != nil {
+			return nil, nil, apperror.ErrDiaryInvalidDate
+		}
+		// 종료 날짜를 포함하도록 다음 날 0시 미만으로 조회
+		parsed = parsed.AddDate(0, 0, 1)
+		toTime = &parsed
+	}
+	if fromTime != nil && toTime != nil && !fromTime.Before(*toTime) {
+		return nil, nil, apperror.ErrDiaryInvalidDateRange
+	}
+
+	return fromTime, toTime, nil
+}
+
+// Validate 함수는 DiaryListFilterDTO의 날짜와 검색식을 사용자 시간대 기준으로 해석합니다.
+func (d *DiaryListFilterDTO) Validate() error {
+	location := d.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	fromTime, toTime, err := parseDateRange(d.From, d.To, location)
+	if err != nil {
+		return err
+	}
+	d.FromTime, d.ToTime = fromTime, toTime
+
+	moods, err := normalizeMoodCodes(d.Moods)
+	if err != nil {
+		return err
+	}
+	d.Moods = moods
+
+	tags, err := normalizeTagNames(d.Tags)
+	if err != nil {
+		if errors.Is(err, apperror.ErrTagTooMany) {
+			return apperror.ErrTagFilterTooMany
+		}
+		return err
+	}
+	// 태그 이름은 대소문자 구분 없이 비교
+	for i, tag := range tags {
+		tags[i] = strings.ToLower(tag)
+	}
+	d.Tags = tags
+
+	d.TagMode = strings.ToLower(strings.TrimSpace(d.TagMode))
+	if d.TagMode == "" {
+		d.TagMode = TAG_FILTER_MODE_ALL
+	}
+	if d.TagMode != TAG_FILTER_MODE_ALL && d.TagMode != TAG_FILTER_MODE_ANY {
+		return apperror.ErrTagFilterInvalidMode
+	}
+
+	d.Search = nil
+	d.Query = strings.TrimSpace(d.Query)
+	if d.Query == "" {
+		return nil
+	}
+
+	search, err := parseSearchQuery(d.Query, location)
+	if err != nil {
+		return err
+	}
+	d.Search = search
+
+	return nil
+}
+
 // GetDiariesByCreatorIDResponseDTO 구조체는 일기 목록 조회 응답 DTO입니다.
 type GetDiariesByCreatorIDResponseDTO struct {
 	Diaries []model.Diary `json:"diaries"`
+	CursorPageInfoDTO
+}
+
+// GetTrashedDiariesResponseDTO 구조체는 휴지통 일기 목록 조회 응답 DTO입니다.
+// RetentionDays는 삭제 후 영구 삭제될 때까지 휴지통에 보관되는 일수입니다.
+type GetTrashedDiariesResponseDTO struct {
+	Diaries       []model.Diary `json:"diaries"`
+	RetentionDays int           `json:"retention_days"`
+	CursorPageInfoDTO
 }
 
 // CreateDiaryDTO 구조체는 신규 일기 생성 요청 DTO입니다.
+// Mood는 감정 코드, MoodIntensity는 1~5의 감정 강도이며 모두 생략할 수 있습니다.
+// Tags는 태그 이름 목록이며 아직 없는 태그는 일기를 저장할 때 함께 생성됩니다.
 type CreateDiaryDTO struct {
-	Title      string `json:"title"`
-	Content    string `json:"content"`
-	CategoryID *int64 `json:"category_id"`
+	Title         string   `json:"title"`
+	Content       string   `json:"content"`
+	CategoryID    *int64   `json:"category_id"`
+	Mood          *string  `json:"mood"`
+	MoodIntensity *int     `json:"mood_intensity"`
+	Tags          []string `json:"tags"`
 }
 
-// Validate 함수는 CreateDiaryDTO의 입력 유효성을 검사합니다.
+// Validate 함수는 CreateDiaryDTO의 입력 유효성을 검사합니다. 빈 감정은 감정 없음으로 처리합니다.
 func (dto *CreateDiaryDTO) Validate() error {
 	if strings.TrimSpace(dto.Title) == "" {
 		return apperror.ErrDiaryCreateTitleRequired
@@ -27,16 +149,35 @@ func (dto *CreateDiaryDTO) Validate() error {
 	if strings.TrimSpace(dto.Content) == "" {
 		return apperror.ErrDiaryCreateContentRequired
 	}
+	if dto.CategoryID != nil && *dto.CategoryID <= 0 {
+		return apperror.ErrDiaryInvalidCategoryID
+	}
+
+	mood, err := normalizeDiaryMood(dto.Mood, dto.MoodIntensity)
+	if err != nil {
+		return err
+	}
+	dto.Mood = mood
+
+	tags, err := normalizeTagNames(dto.Tags)
+	if err != nil {
+		return err
+	}
+	dto.Tags = tags
+
 	return nil
 }
 
 // ToModel 함수는 CreateDiaryDTO를 model.Diary로 변환합니다.
 func (dto *CreateDiaryDTO) ToModel(creatorID int64) *model.Diary {
 	return &model.Diary{
-		Title:      dto.Title,
-		Content:    dto.Content,
-		CreatorID:  creatorID,
-		CategoryID: dto.CategoryID,
+		Title:         dto.Title,
+		Content:       dto.Content,
+		CreatorID:     creatorID,
+		CategoryID:    dto.CategoryID,
+		Mood:          dto.Mood,
+		MoodIntensity: dto.MoodIntensity,
+		Tags:          dto.Tags,
 	}
 }
 
@@ -51,9 +192,18 @@ type GetDiaryByIDResponseDTO struct {
 }
 
 // UpdateDiaryDTO 구조체는 일기 수정 요청 DTO입니다.
+// Mood, MoodIntensity를 모두 생략하면 기존 감정을 유지하고, Mood를 빈 문자열로 보내면 감정을 삭제합니다.
+// Tags를 생략하면 기존 태그를 유지하고, 빈 배열로 보내면 모든 태그를 해제합니다.
+// IfMatch는 요청의 If-Match 헤더 값이며, 지정하면 일기의 현재 ETag와 일치할 때만 수정합니다.
 type UpdateDiaryDTO struct {
-	Title   string `json:"title"`
-	Content string `json:"content"`
+	Title         string    `json:"title"`
+	Content       string    `json:"content"`
+	Mood          *string   `json:"mood"`
+	MoodIntensity *int      `json:"mood_intensity"`
+	Tags          *[]string `json:"tags"`
+	IfMatch       string    `json:"-"`
+
+	clearMood bool // Validate에서 감정 삭제 요청으로 해석한 경우 true
 }
 
 // Validate 함수는 UpdateDiaryDTO의 입력 유효성을 검사합니다.
@@ -64,15 +214,42 @@ func (dto *UpdateDiaryDTO) Validate() error {
 	if strings.TrimSpace(dto.Content) == "" {
 		return apperror.ErrDiaryUpdateContentRequired
 	}
+
+	dto.clearMood = dto.Mood != nil && strings.TrimSpace(*dto.Mood) == ""
+	mood, err := normalizeDiaryMood(dto.Mood, dto.MoodIntensity)
+	if err != nil {
+		return err
+	}
+	dto.Mood = mood
+
+	if dto.Tags != nil {
+		tags, err := normalizeTagNames(*dto.Tags)
+		if err != nil {
+			return err
+		}
+		dto.Tags = &tags
+	}
+
 	return nil
 }
 
-// ToModel 함수는 UpdateDiaryDTO를 model.Diary로 변환합니다.
+// MoodChanged 함수는 감정을 변경하거나 삭제하는 요청인지 여부를 반환합니다.
+func (dto *UpdateDiaryDTO) MoodChanged() bool {
+	return dto.Mood != nil || dto.clearMood
+}
+
+// ToModel 함수는 UpdateDiaryDTO를 model.Diary로 변환합니다. 감정이나 태그를 변경하지 않는 요청이면 해당 값은 비워 둡니다.
 func (dto *UpdateDiaryDTO) ToModel() *model.Diary {
-	return &model.Diary{
-		Title:   dto.Title,
-		Content: dto.Content,
+	diary := &model.Diary{
+		Title:         dto.Title,
+		Content:       dto.Content,
+		Mood:          dto.Mood,
+		MoodIntensity: dto.MoodIntensity,
+	}
+	if dto.Tags != nil {
+		diary.Tags = *dto.Tags
 	}
+	return diary
 }
 
 // UpdateDiaryResponseDTO 구조체는 일기 수정 응답 DTO입니다.
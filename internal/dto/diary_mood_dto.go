@@ -0,0 +1,123 @@
+package dto
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/searchquery"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+const (
+	MOOD_INTENSITY_MIN = 1 // 감정 강도 최솟값
+	MOOD_INTENSITY_MAX = 5 // 감정 강도 최댓값
+
+	// 감정 분포 집계 단위 ( PostgreSQL DATE_TRUNC 단위와 같음 )
+	MOOD_STATS_INTERVAL_DAY   = "day"
+	MOOD_STATS_INTERVAL_WEEK  = "week" // 월요일 시작
+	MOOD_STATS_INTERVAL_MONTH = "month"
+)
+
+// normalizeMoodCode 함수는 감정 코드의 공백을 제거하고 소문자로 변환합니다.
+func normalizeMoodCode(code string) (string, error) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if utf8.RuneCountInString(code) > searchquery.MOOD_CODE_MAX_LENGTH {
+		return "", apperror.ErrDiaryInvalidMood
+	}
+	return code, nil
+}
+
+// normalizeDiaryMood 함수는 일기 감정과 강도를 검사하고 정규화한 감정 코드를 반환합니다.
+// 감정이 생략되었거나 비어 있으면 nil을 반환하며, 이때 강도는 지정할 수 없습니다. 감정 코드의 존재 여부는 서비스 계층에서 확인합니다.
+func normalizeDiaryMood(mood *string, intensity *int) (*string, error) {
+	if intensity != nil && (*intensity < MOOD_INTENSITY_MIN || *intensity > MOOD_INTENSITY_MAX) {
+		return nil, apperror.ErrDiaryInvalidMoodIntensity
+	}
+
+	if mood == nil || strings.TrimSpace(*mood) == "" {
+		if intensity != nil {
+			return nil, apperror.ErrDiaryMoodIntensityWithoutMood
+		}
+		return nil, nil
+	}
+
+	code, err := normalizeMoodCode(*mood)
+	if err != nil {
+		return nil, err
+	}
+	return &code, nil
+}
+
+// normalizeMoodCodes 함수는 목록 필터의 감정 코드들을 정규화하고 빈 값과 중복을 제거합니다.
+func normalizeMoodCodes(codes []string) ([]string, error) {
+	normalized := []string{}
+	seen := map[string]bool{}
+	for _, code := range codes {
+		code, err := normalizeMoodCode(code)
+		if err != nil {
+			return nil, err
+		}
+		if code == "" || seen[code] {
+			continue
+		}
+		seen[code] = true
+		normalized = append(normalized, code)
+	}
+	return normalized, nil
+}
+
+// ListMoodsResponseDTO 구조체는 감정 목록 조회 응답 DTO입니다.
+type ListMoodsResponseDTO struct {
+	Moods []*model.Mood `json:"moods"`
+}
+
+// MoodStatsDTO 구조체는 기간별 감정 분포 조회 요청 DTO입니다.
+// From, To(YYYY-MM-DD)는 사용자 시간대의 날짜이며 두 날짜 모두 포함합니다. 생략하면 전체 기간을 집계합니다.
+type MoodStatsDTO struct {
+	From     string
+	To       string
+	Interval string
+
+	// Location은 날짜와 기간을 계산할 사용자 시간대입니다. nil이면 UTC를 사용합니다.
+	Location *time.Location
+
+	// Validate에서 계산되는 값입니다. FromTime 이상, ToTime 미만의 작성 시각을 집계하며, 생략된 날짜는 nil입니다.
+	FromTime *time.Time
+	ToTime   *time.Time
+}
+
+// Validate 함수는 MoodStatsDTO의 입력 유효성을 검사하고 생략된 값에 기본값을 채웁니다.
+func (d *MoodStatsDTO) Validate() error {
+	if d.Location == nil {
+		d.Location = time.UTC
+	}
+
+	d.Interval = strings.ToLower(strings.TrimSpace(d.Interval))
+	if d.Interval == "" {
+		d.Interval = MOOD_STATS_INTERVAL_MONTH
+	}
+	if d.Interval != MOOD_STATS_INTERVAL_DAY && d.Interval != MOOD_STATS_INTERVAL_WEEK && d.Interval != MOOD_STATS_INTERVAL_MONTH {
+		return apperror.ErrDiaryInvalidMoodInterval
+	}
+
+	fromTime, toTime, err := parseDateRange(d.From, d.To, d.Location)
+	if err != nil {
+		return err
+	}
+	d.FromTime, d.ToTime = fromTime, toTime
+
+	return nil
+}
+
+// MoodStatsResponseDTO 구조체는 기간별 감정 분포 조회 응답 DTO입니다.
+// Periods는 감정을 지정한 일기가 있는 기간만 오래된 순으로 포함하며, Totals는 조회 구간 전체의 감정별 합계입니다.
+type MoodStatsResponseDTO struct {
+	Interval string              `json:"interval"`
+	Timezone string              `json:"timezone"`
+	From     string              `json:"from,omitempty"`
+	To       string              `json:"to,omitempty"`
+	Periods  []*model.MoodPeriod `json:"periods"`
+	Totals   []*model.MoodCount  `json:"totals"`
+}
@@ -0,0 +1,147 @@
+package dto
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// 부분 수정할 수 있는 일기 항목 ( JSON 키 )
+const (
+	DIARY_PATCH_FIELD_TITLE          = "title"
+	DIARY_PATCH_FIELD_CONTENT        = "content"
+	DIARY_PATCH_FIELD_CATEGORY_ID    = "category_id"
+	DIARY_PATCH_FIELD_MOOD           = "mood"
+	DIARY_PATCH_FIELD_MOOD_INTENSITY = "mood_intensity"
+	DIARY_PATCH_FIELD_TAGS           = "tags"
+)
+
+// PatchDiaryDTO 구조체는 JSON Merge Patch(RFC 7396) 형식의 일기 부분 수정 요청 DTO입니다.
+// 본문에 없는 항목은 기존 값을 유지하고, null로 보낸 항목은 값을 비웁니다. 제목과 내용은 비울 수 없습니다.
+// IfMatch는 요청의 If-Match 헤더 값이며, 지정하면 일기의 현재 ETag와 일치할 때만 수정합니다.
+type PatchDiaryDTO struct {
+	Title         *string
+	Content       *string
+	CategoryID    *int64
+	Mood          *string
+	MoodIntensity *int
+	Tags          []string
+	IfMatch       string
+
+	present map[string]bool // 본문에 포함된 항목 ( null 포함 )
+}
+
+// UnmarshalJSON 함수는 Merge Patch 본문을 읽어 항목별 값과 포함 여부를 기록합니다.
+// 수정할 수 없는 항목이나 형식이 맞지 않는 값이 있으면 오류를 반환합니다.
+func (d *PatchDiaryDTO) UnmarshalJSON(data []byte) error {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return apperror.ErrDiaryPatchInvalidBody
+	}
+
+	d.present = map[string]bool{}
+	for key, raw := range fields {
+		var target interface{}
+		switch key {
+		case DIARY_PATCH_FIELD_TITLE:
+			target = &d.Title
+		case DIARY_PATCH_FIELD_CONTENT:
+			target = &d.Content
+		case DIARY_PATCH_FIELD_CATEGORY_ID:
+			target = &d.CategoryID
+		case DIARY_PATCH_FIELD_MOOD:
+			target = &d.Mood
+		case DIARY_PATCH_FIELD_MOOD_INTENSITY:
+			target = &d.MoodIntensity
+		case DIARY_PATCH_FIELD_TAGS:
+			target = &d.Tags
+		default:
+			return fmt.Errorf("%w: %s", apperror.ErrDiaryPatchUnknownField, key)
+		}
+
+		if !bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			if err := json.Unmarshal(raw, target); err != nil {
+				return fmt.Errorf("%w: %s", apperror.ErrDiaryPatchInvalidField, key)
+			}
+		}
+		d.present[key] = true
+	}
+
+	return nil
+}
+
+// Has 함수는 본문에 해당 항목이 포함되었는지 여부를 반환합니다. null로 보낸 항목도 포함된 것으로 봅니다.
+func (d *PatchDiaryDTO) Has(field string) bool {
+	return d.present[field]
+}
+
+// Validate 함수는 PatchDiaryDTO의 입력 유효성을 검사합니다. 제목, 내용, 감정, 태그 값은 Apply 결과의 UpdateDiaryDTO에서 검사합니다.
+func (d *PatchDiaryDTO) Validate() error {
+	if len(d.present) == 0 {
+		return apperror.ErrDiaryPatchEmpty
+	}
+	if d.Has(DIARY_PATCH_FIELD_TITLE) && d.Title == nil {
+		return apperror.ErrDiaryUpdateTitleRequired
+	}
+	if d.Has(DIARY_PATCH_FIELD_CONTENT) && d.Content == nil {
+		return apperror.ErrDiaryUpdateContentRequired
+	}
+	if d.CategoryID != nil && *d.CategoryID <= 0 {
+		return apperror.ErrDiaryInvalidCategoryID
+	}
+	return nil
+}
+
+// Apply 함수는 부분 수정 내용을 현재 일기에 합쳐 전체 수정 요청(UpdateDiaryDTO)으로 변환합니다.
+// 감정만 비우면 감정 강도도 함께 비우며, 감정 강도만 보내면 기존 감정을 유지합니다. 카테고리는 CategoryFor로 구합니다.
+func (d *PatchDiaryDTO) Apply(diary *model.Diary) UpdateDiaryDTO {
+	updateDTO := UpdateDiaryDTO{
+		Title:   diary.Title,
+		Content: diary.Content,
+		IfMatch: d.IfMatch,
+	}
+	if d.Has(DIARY_PATCH_FIELD_TITLE) {
+		updateDTO.Title = *d.Title
+	}
+	if d.Has(DIARY_PATCH_FIELD_CONTENT) {
+		updateDTO.Content = *d.Content
+	}
+
+	// 감정 관련 항목이 없으면 UpdateDiaryDTO에서도 비워 두어 기존 감정을 유지
+	if d.Has(DIARY_PATCH_FIELD_MOOD) || d.Has(DIARY_PATCH_FIELD_MOOD_INTENSITY) {
+		mood, intensity := diary.Mood, diary.MoodIntensity
+		if d.Has(DIARY_PATCH_FIELD_MOOD) {
+			mood = d.Mood
+			if mood == nil {
+				// 빈 문자열은 UpdateDiaryDTO에서 감정 삭제 요청
+				empty := ""
+				mood, intensity = &empty, nil
+			}
+		}
+		if d.Has(DIARY_PATCH_FIELD_MOOD_INTENSITY) {
+			intensity = d.MoodIntensity
+		}
+		updateDTO.Mood, updateDTO.MoodIntensity = mood, intensity
+	}
+
+	if d.Has(DIARY_PATCH_FIELD_TAGS) {
+		tags := d.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		updateDTO.Tags = &tags
+	}
+
+	return updateDTO
+}
+
+// CategoryFor 함수는 부분 수정 후의 카테고리 ID를 반환합니다. 본문에 없으면 현재 카테고리를 유지합니다.
+func (d *PatchDiaryDTO) CategoryFor(diary *model.Diary) *int64 {
+	if d.Has(DIARY_PATCH_FIELD_CATEGORY_ID) {
+		return d.CategoryID
+	}
+	return diary.CategoryID
+}
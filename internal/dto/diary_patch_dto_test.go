@@ -0,0 +1,201 @@
+package dto
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// ptr 함수는 테스트 값의 포인터를 반환합니다.
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// newTestDiary 함수는 모든 항목이 채워진 현재 일기를 반환합니다.
+func newTestDiary() *model.Diary {
+	return &model.Diary{
+		ID:            1,
+		CategoryID:    ptr(int64(7)),
+		Title:         "제목",
+		Content:       "내용",
+		Mood:          ptr("happy"),
+		MoodIntensity: ptr(4),
+		Tags:          []string{"여행"},
+	}
+}
+
+func TestPatchDiaryDTOUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantPresent []string
+		wantErr     error
+	}{
+		{name: "빈 본문", body: `{}`},
+		{name: "값이 있는 항목", body: `{"title":"새 제목","category_id":3}`, wantPresent: []string{DIARY_PATCH_FIELD_TITLE, DIARY_PATCH_FIELD_CATEGORY_ID}},
+		{name: "null 항목도 포함", body: `{"mood": null, "tags":null}`, wantPresent: []string{DIARY_PATCH_FIELD_MOOD, DIARY_PATCH_FIELD_TAGS}},
+		{name: "알 수 없는 항목", body: `{"title":"새 제목","creator_id":2}`, wantErr: apperror.ErrDiaryPatchUnknownField},
+		{name: "수정할 수 없는 항목", body: `{"version":3}`, wantErr: apperror.ErrDiaryPatchUnknownField},
+		{name: "형식이 맞지 않는 값", body: `{"mood_intensity":"high"}`, wantErr: apperror.ErrDiaryPatchInvalidField},
+		{name: "태그 형식 오류", body: `{"tags":"여행"}`, wantErr: apperror.ErrDiaryPatchInvalidField},
+		{name: "객체가 아닌 본문", body: `[]`, wantErr: apperror.ErrDiaryPatchInvalidBody},
+		{name: "null 본문", body: `null`},
+	}
+
+	allFields := []string{
+		DIARY_PATCH_FIELD_TITLE, DIARY_PATCH_FIELD_CONTENT, DIARY_PATCH_FIELD_CATEGORY_ID,
+		DIARY_PATCH_FIELD_MOOD, DIARY_PATCH_FIELD_MOOD_INTENSITY, DIARY_PATCH_FIELD_TAGS,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patchDTO PatchDiaryDTO
+			err := json.Unmarshal([]byte(tt.body), &patchDTO)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unmarshal(%s) error = %v, want %v", tt.body, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			want := map[string]bool{}
+			for _, field := range tt.wantPresent {
+				want[field] = true
+			}
+			for _, field := range allFields {
+				if got := patchDTO.Has(field); got != want[field] {
+					t.Errorf("Has(%s) = %v, want %v", field, got, want[field])
+				}
+			}
+		})
+	}
+}
+
+func TestPatchDiaryDTOValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr error
+	}{
+		{name: "빈 본문", body: `{}`, wantErr: apperror.ErrDiaryPatchEmpty},
+		{name: "제목 null", body: `{"title":null}`, wantErr: apperror.ErrDiaryUpdateTitleRequired},
+		{name: "내용 null", body: `{"content":null}`, wantErr: apperror.ErrDiaryUpdateContentRequired},
+		{name: "잘못된 카테고리 ID", body: `{"category_id":0}`, wantErr: apperror.ErrDiaryInvalidCategoryID},
+		{name: "카테고리 null", body: `{"category_id":null}`},
+		{name: "감정 null", body: `{"mood":null}`},
+		{name: "제목 변경", body: `{"title":"새 제목"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patchDTO PatchDiaryDTO
+			if err := json.Unmarshal([]byte(tt.body), &patchDTO); err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", tt.body, err)
+			}
+			if err := patchDTO.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate(%s) error = %v, want %v", tt.body, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPatchDiaryDTOApply(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		want         UpdateDiaryDTO
+		wantCategory *int64
+	}{
+		{
+			name:         "없는 항목은 유지",
+			body:         `{"title":"새 제목"}`,
+			want:         UpdateDiaryDTO{Title: "새 제목", Content: "내용"},
+			wantCategory: ptr(int64(7)),
+		},
+		{
+			name:         "카테고리 변경",
+			body:         `{"category_id":3}`,
+			want:         UpdateDiaryDTO{Title: "제목", Content: "내용"},
+			wantCategory: ptr(int64(3)),
+		},
+		{
+			name:         "카테고리 null은 해제",
+			body:         `{"category_id":null}`,
+			want:         UpdateDiaryDTO{Title: "제목", Content: "내용"},
+			wantCategory: nil,
+		},
+		{
+			name:         "감정 null은 감정 강도도 비움",
+			body:         `{"mood":null}`,
+			want:         UpdateDiaryDTO{Title: "제목", Content: "내용", Mood: ptr(""), MoodIntensity: nil},
+			wantCategory: ptr(int64(7)),
+		},
+		{
+			name:         "감정만 변경하면 감정 강도 유지",
+			body:         `{"mood":"sad"}`,
+			want:         UpdateDiaryDTO{Title: "제목", Content: "내용", Mood: ptr("sad"), MoodIntensity: ptr(4)},
+			wantCategory: ptr(int64(7)),
+		},
+		{
+			name:         "감정 강도만 변경하면 감정 유지",
+			body:         `{"mood_intensity":2}`,
+			want:         UpdateDiaryDTO{Title: "제목", Content: "내용", Mood: ptr("happy"), MoodIntensity: ptr(2)},
+			wantCategory: ptr(int64(7)),
+		},
+		{
+			name:         "감정 강도 null",
+			body:         `{"mood_intensity":null}`,
+			want:         UpdateDiaryDTO{Title: "제목", Content: "내용", Mood: ptr("happy"), MoodIntensity: nil},
+			wantCategory: ptr(int64(7)),
+		},
+		{
+			name:         "태그 null은 모두 해제",
+			body:         `{"tags":null}`,
+			want:         UpdateDiaryDTO{Title: "제목", Content: "내용", Tags: ptr([]string{})},
+			wantCategory: ptr(int64(7)),
+		},
+		{
+			name:         "태그 교체",
+			body:         `{"tags":["일상","산책"]}`,
+			want:         UpdateDiaryDTO{Title: "제목", Content: "내용", Tags: ptr([]string{"일상", "산책"})},
+			wantCategory: ptr(int64(7)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patchDTO PatchDiaryDTO
+			if err := json.Unmarshal([]byte(tt.body), &patchDTO); err != nil {
+				t.Fatalf("Unmarshal(%s) error = %v", tt.body, err)
+			}
+			diary := newTestDiary()
+
+			if got := patchDTO.Apply(diary); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Apply(%s) = %+v, want %+v", tt.body, got, tt.want)
+			}
+			if got := patchDTO.CategoryFor(diary); !reflect.DeepEqual(got, tt.wantCategory) {
+				t.Errorf("CategoryFor(%s) = %v, want %v", tt.body, got, tt.wantCategory)
+			}
+		})
+	}
+}
+
+// TestPatchDiaryDTOApplyClearMood는 감정 null이 UpdateDiaryDTO 검사에서 감정 삭제 요청으로 해석되는지 확인합니다.
+func TestPatchDiaryDTOApplyClearMood(t *testing.T) {
+	var patchDTO PatchDiaryDTO
+	if err := json.Unmarshal([]byte(`{"mood":null}`), &patchDTO); err != nil {
+		t.Fatal(err)
+	}
+
+	updateDTO := patchDTO.Apply(newTestDiary())
+	if err := updateDTO.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if !updateDTO.MoodChanged() || updateDTO.Mood != nil || updateDTO.MoodIntensity != nil {
+		t.Errorf("MoodChanged() = %v, Mood = %v, MoodIntensity = %v, want clear request", updateDTO.MoodChanged(), updateDTO.Mood, updateDTO.MoodIntensity)
+	}
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+const (
+	DIARY_REVISION_LIMIT_MIN = 1   // 일기별 수정 기록 보관 개수 최솟값
+	DIARY_REVISION_LIMIT_MAX = 200 // 일기별 수정 기록 보관 개수 최댓값
+
+	// 수정 기록 비교 단위
+	DIARY_REVISION_DIFF_MODE_LINE = "line"
+	DIARY_REVISION_DIFF_MODE_WORD = "word"
+)
+
+// DiaryRevisionsResponseDTO 구조체는 일기 수정 기록 목록 조회 응답 DTO입니다.
+// Limit은 사용자의 수정 기록 보관 개수 설정입니다.
+type DiaryRevisionsResponseDTO struct {
+	Revisions []*model.DiaryRevision `json:"revisions"`
+	Limit     int                    `json:"limit"`
+}
+
+// DiaryRevisionResponseDTO 구조체는 일기 수정 기록 단건 조회 응답 DTO입니다.
+type DiaryRevisionResponseDTO struct {
+	Revision *model.DiaryRevision `json:"revision"`
+}
+
+// DiaryRevisionDiffDTO 구조체는 두 수정 기록의 비교 요청 DTO입니다.
+// To를 생략하면 최신 수정 기록, From을 생략하면 To 바로 이전의 수정 기록과 비교합니다.
+type DiaryRevisionDiffDTO struct {
+	From int
+	To   int
+	Mode string
+}
+
+// Validate 함수는 DiaryRevisionDiffDTO의 입력 유효성을 검사하고 생략된 값에 기본값을 채웁니다.
+func (d *DiaryRevisionDiffDTO) Validate() error {
+	if d.From < 0 || d.To < 0 {
+		return apperror.ErrDiaryRevisionInvalidNumber
+	}
+
+	if d.Mode == "" {
+		d.Mode = DIARY_REVISION_DIFF_MODE_LINE
+	}
+	if d.Mode != DIARY_REVISION_DIFF_MODE_LINE && d.Mode != DIARY_REVISION_DIFF_MODE_WORD {
+		return apperror.ErrDiaryRevisionInvalidDiffMode
+	}
+
+	return nil
+}
+
+// DiaryRevisionDiffResponseDTO 구조체는 두 수정 기록의 비교 응답 DTO입니다.
+// 제목은 항상 단어 단위로, 내용은 Mode 단위로 비교합니다.
+type DiaryRevisionDiffResponseDTO struct {
+	From    int              `json:"from"`
+	To      int              `json:"to"`
+	Mode    string           `json:"mode"`
+	Title   utils.DiffResult `json:"title"`
+	Content utils.DiffResult `json:"content"`
+}
@@ -0,0 +1,111 @@
+package dto
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/searchquery"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+const (
+	DIARY_SEARCH_DEFAULT_LIMIT    = 20  // 검색 결과 기본 페이지 크기
+	DIARY_SEARCH_MAX_LIMIT        = 100 // 검색 결과 최대 페이지 크기
+	DIARY_SEARCH_QUERY_MAX_LENGTH = 100 // 검색어 최대 길이
+	DIARY_SEARCH_MAX_TERMS        = 10  // 검색식에 포함할 수 있는 최대 검색어, 연산자 수
+	DIARY_SEARCH_SNIPPET_RADIUS   = 60  // 스니펫에 포함할 검색어 앞뒤 글자 수
+	DIARY_SEARCH_SUGGESTION_LIMIT = 10  // 자동 완성 최대 추천 수
+)
+
+// SearchDiariesDTO 구조체는 일기 제목, 본문 검색 요청 DTO입니다.
+// Query는 검색식(searchquery 문법)이며, 일반 검색어와 구문이 모두 제목 또는 본문에 포함된 일기를 관련도 순으로 조회합니다.
+type SearchDiariesDTO struct {
+	Query      string
+	CategoryID *int64
+	Page       int
+	Limit      int
+
+	// Location은 before, after 날짜를 해석할 사용자 시간대입니다.
+	Location *time.Location
+
+	// Search는 Validate에서 Query를 해석한 결과입니다.
+	Search *searchquery.Query
+}
+
+// parseSearchQuery 함수는 검색식의 길이와 검색어 수를 확인하고 사용자 시간대 기준으로 해석합니다.
+func parseSearchQuery(query string, location *time.Location) (*searchquery.Query, error) {
+	if utf8.RuneCountInString(query) > DIARY_SEARCH_QUERY_MAX_LENGTH {
+		return nil, apperror.ErrDiarySearchQueryTooLong
+	}
+
+	parsed, err := searchquery.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed.Terms) > DIARY_SEARCH_MAX_TERMS {
+		return nil, apperror.ErrDiarySearchTooManyTerms
+	}
+	parsed.Location = location
+
+	return parsed, nil
+}
+
+// Validate 함수는 SearchDiariesDTO의 입력 유효성을 검사하고 검색식을 해석합니다. 생략된 값에는 기본값을 채웁니다.
+func (d *SearchDiariesDTO) Validate() error {
+	d.Query = strings.TrimSpace(d.Query)
+	if d.Query == "" {
+		return apperror.ErrDiarySearchQueryRequired
+	}
+
+	search, err := parseSearchQuery(d.Query, d.Location)
+	if err != nil {
+		return err
+	}
+	d.Search = search
+
+	if d.Page == 0 {
+		d.Page = 1
+	}
+	if d.Page < 1 {
+		return apperror.ErrHttpInvalidPage
+	}
+
+	if d.Limit == 0 {
+		d.Limit = DIARY_SEARCH_DEFAULT_LIMIT
+	}
+	if d.Limit < 1 || d.Limit > DIARY_SEARCH_MAX_LIMIT {
+		return apperror.ErrHttpInvalidLimit
+	}
+
+	return nil
+}
+
+// Offset 함수는 페이지 번호와 크기로 건너뛸 행 수를 계산합니다.
+func (d *SearchDiariesDTO) Offset() int {
+	return (d.Page - 1) * d.Limit
+}
+
+// SearchDiariesResponseDTO 구조체는 일기 검색 응답 DTO입니다.
+type SearchDiariesResponseDTO struct {
+	Results []*model.DiarySearchResult `json:"results"`
+	Total   int64                      `json:"total"`
+	Page    int                        `json:"page"`
+	Limit   int                        `json:"limit"`
+}
+
+// DiarySearchSuggestionDTO 구조체는 검색식 자동 완성 추천 항목입니다.
+// Text는 입력 중인 토큰(replace_from ~ replace_to 구간)을 대체할 문자열입니다.
+type DiarySearchSuggestionDTO struct {
+	Type        string `json:"type"` // operator, value, category, recent
+	Text        string `json:"text"`
+	Description string `json:"description,omitempty"`
+}
+
+// DiarySearchSuggestionsResponseDTO 구조체는 검색식 자동 완성 응답 DTO입니다.
+type DiarySearchSuggestionsResponseDTO struct {
+	ReplaceFrom int                        `json:"replace_from"` // 교체할 시작 글자 위치
+	ReplaceTo   int                        `json:"replace_to"`   // 교체할 끝 글자 위치
+	Suggestions []DiarySearchSuggestionDTO `json:"suggestions"`
+}
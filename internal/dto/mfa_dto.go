@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// MFAStatusResponseDTO 구조체는 2단계 인증 상태 응답을 위한 데이터 전송 객체입니다.
+type MFAStatusResponseDTO struct {
+	TOTPEnabled            bool `json:"totp_enabled"`
+	RemainingRecoveryCodes int  `json:"remaining_recovery_codes"`
+}
+
+// MFATOTPSetupResponseDTO 구조체는 TOTP 등록 시작 응답을 위한 데이터 전송 객체입니다.
+type MFATOTPSetupResponseDTO struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// MFATOTPConfirmDTO 구조체는 TOTP 등록 확인을 위한 데이터 전송 객체입니다.
+type MFATOTPConfirmDTO struct {
+	Code string `json:"code"`
+}
+
+// Validate 함수는 TOTP 등록 확인 입력 값을 확인해주는 함수입니다.
+func (d *MFATOTPConfirmDTO) Validate() error {
+	if strings.TrimSpace(d.Code) == "" {
+		return apperror.ErrMFACodeRequired
+	}
+
+	return nil
+}
+
+// MFATOTPDisableDTO 구조체는 TOTP 해제를 위한 데이터 전송 객체입니다.
+// 비밀번호와 함께 인증 앱 코드 또는 복구 코드 중 하나가 필요합니다.
+type MFATOTPDisableDTO struct {
+	Password     string `json:"password"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+// Validate 함수는 TOTP 해제 입력 값을 확인해주는 함수입니다.
+func (d *MFATOTPDisableDTO) Validate() error {
+	if strings.TrimSpace(d.Password) == "" {
+		return apperror.ErrMFAPasswordRequired
+	}
+
+	if strings.TrimSpace(d.Code) == "" && strings.TrimSpace(d.RecoveryCode) == "" {
+		return apperror.ErrMFACodeRequired
+	}
+
+	return nil
+}
+
+// MFARecoveryCodesRegenerateDTO 구조체는 복구 코드 재발급을 위한 데이터 전송 객체입니다.
+type MFARecoveryCodesRegenerateDTO struct {
+	Code string `json:"code"`
+}
+
+// Validate 함수는 복구 코드 재발급 입력 값을 확인해주는 함수입니다.
+func (d *MFARecoveryCodesRegenerateDTO) Validate() error {
+	if strings.TrimSpace(d.Code) == "" {
+		return apperror.ErrMFACodeRequired
+	}
+
+	return nil
+}
+
+// MFARecoveryCodesResponseDTO 구조체는 새로 발급된 복구 코드 응답을 위한 데이터 전송 객체입니다.
+// 복구 코드 원문은 이 응답에서 한 번만 확인할 수 있습니다.
+type MFARecoveryCodesResponseDTO struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
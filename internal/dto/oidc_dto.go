@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// OIDCAuthorizeResponseDTO 구조체는 외부 로그인 시작 응답을 위한 데이터 전송 객체입니다.
+// 프론트엔드는 사용자를 AuthorizationURL로 이동시킵니다.
+type OIDCAuthorizeResponseDTO struct {
+	AuthorizationURL string `json:"authorization_url"`
+}
+
+// OIDCCallbackDTO 구조체는 외부 로그인 제공자가 프론트엔드로 전달한 인가 결과를 위한 데이터 전송 객체입니다.
+type OIDCCallbackDTO struct {
+	State      string `json:"state"`
+	Code       string `json:"code"`
+	Error      string `json:"error"` // 사용자가 동의를 거부하는 등 제공자가 에러를 반환한 경우
+	DeviceName string `json:"device_name"`
+
+	IP        string `json:"-"` // 핸들러에서 요청 정보로 설정
+	UserAgent string `json:"-"` // 핸들러에서 요청 정보로 설정
+}
+
+// Validate 함수는 외부 로그인 콜백 입력 값을 확인해주는 함수입니다.
+func (d *OIDCCallbackDTO) Validate() error {
+	if strings.TrimSpace(d.Error) != "" {
+		return apperror.ErrOIDCProviderError
+	}
+
+	if strings.TrimSpace(d.State) == "" {
+		return apperror.ErrOIDCStateRequired
+	}
+
+	if strings.TrimSpace(d.Code) == "" {
+		return apperror.ErrOIDCCodeRequired
+	}
+
+	return nil
+}
+
+// UserIdentitiesResponseDTO 구조체는 연결된 외부 계정 목록 응답을 위한 데이터 전송 객체입니다.
+type UserIdentitiesResponseDTO struct {
+	Identities  []*model.UserIdentity `json:"identities"`
+	HasPassword bool                  `json:"has_password"` // false이면 마지막 외부 계정은 연결 해제할 수 없음
+}
+
+// UserIdentityResponseDTO 구조체는 외부 계정 연결 응답을 위한 데이터 전송 객체입니다.
+type UserIdentityResponseDTO struct {
+	Identity *model.UserIdentity `json:"identity"`
+}
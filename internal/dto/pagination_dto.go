@@ -0,0 +1,138 @@
+package dto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+const (
+	CURSOR_PAGE_DEFAULT_LIMIT = 20  // 커서 페이지 기본 크기
+	CURSOR_PAGE_MAX_LIMIT     = 100 // 커서 페이지 최대 크기
+
+	CURSOR_DIRECTION_NEXT = "next" // 커서 이후(더 오래된) 항목 조회
+	CURSOR_DIRECTION_PREV = "prev" // 커서 이전(더 최신) 항목 조회
+)
+
+// PageCursor 구조체는 (created_at, id) 정렬 기준의 목록에서 페이지 경계가 되는 항목의 위치와 이동 방향입니다.
+// 클라이언트에는 내부 구조를 알 수 없는 불투명한 문자열로 전달됩니다.
+type PageCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        int64     `json:"i"`
+	Direction string    `json:"d"`
+}
+
+// Encode 함수는 커서를 URL에 그대로 사용할 수 있는 문자열로 변환합니다.
+func (c PageCursor) Encode() string {
+	payload, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+// decodePageCursor 함수는 Encode로 만든 문자열을 커서로 되돌립니다.
+func decodePageCursor(value string) (*PageCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, apperror.ErrHttpInvalidCursor
+	}
+
+	cursor := &PageCursor{}
+	if err := json.Unmarshal(payload, cursor); err != nil {
+		return nil, apperror.ErrHttpInvalidCursor
+	}
+	if cursor.ID < 1 || cursor.CreatedAt.IsZero() {
+		return nil, apperror.ErrHttpInvalidCursor
+	}
+	if cursor.Direction != CURSOR_DIRECTION_NEXT && cursor.Direction != CURSOR_DIRECTION_PREV {
+		return nil, apperror.ErrHttpInvalidCursor
+	}
+
+	return cursor, nil
+}
+
+// CursorPageDTO 구조체는 키셋(커서) 페이지네이션 요청 DTO입니다.
+// Cursor가 비어 있으면 첫 페이지를 조회하며, IncludeTotal이 true이면 전체 개수도 함께 조회합니다.
+type CursorPageDTO struct {
+	Limit        int
+	Cursor       string
+	IncludeTotal bool
+
+	// Position은 Validate에서 Cursor를 해석한 결과입니다. 첫 페이지이면 nil입니다.
+	Position *PageCursor
+}
+
+// Validate 함수는 CursorPageDTO의 입력 유효성을 검사하고 커서를 해석합니다.
+func (d *CursorPageDTO) Validate() error {
+	if d.Limit == 0 {
+		d.Limit = CURSOR_PAGE_DEFAULT_LIMIT
+	}
+	if d.Limit < 1 || d.Limit > CURSOR_PAGE_MAX_LIMIT {
+		return apperror.ErrHttpInvalidLimit
+	}
+
+	d.Position = nil
+	if d.Cursor != "" {
+		position, err := decodePageCursor(d.Cursor)
+		if err != nil {
+			return err
+		}
+		d.Position = position
+	}
+
+	return nil
+}
+
+// IsPrev 함수는 이전 페이지(더 최신 항목) 방향으로 조회하는지 여부를 반환합니다.
+func (d *CursorPageDTO) IsPrev() bool {
+	return d.Position != nil && d.Position.Direction == CURSOR_DIRECTION_PREV
+}
+
+// CursorPageInfoDTO 구조체는 커서 페이지네이션 응답에 포함되는 페이지 정보입니다.
+// 더 이상 이동할 페이지가 없으면 해당 커서는 null이며, total은 요청한 경우에만 포함됩니다.
+type CursorPageInfoDTO struct {
+	NextCursor *string `json:"next_cursor"`
+	PrevCursor *string `json:"prev_cursor"`
+	Total      *int64  `json:"total,omitempty"`
+}
+
+// NewCursorPage 함수는 저장소가 Limit보다 하나 더 조회한 결과로 페이지 항목과 페이지 정보를 만듭니다.
+// 이전 페이지 방향으로 조회한 결과는 오래된 순이므로 최신 순으로 되돌립니다. key는 항목의 정렬 기준 값을 반환해야 합니다.
+func NewCursorPage[T any](items []T, page CursorPageDTO, key func(T) PageCursor) ([]T, CursorPageInfoDTO) {
+	hasMore := len(items) > page.Limit
+	if hasMore {
+		items = items[:page.Limit]
+	}
+	if page.IsPrev() {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+
+	info := CursorPageInfoDTO{}
+	if len(items) == 0 {
+		return items, info
+	}
+
+	// 다음 방향으로 조회했다면 커서 이전 항목이 존재하고, 이전 방향으로 조회했다면 커서 이후 항목이 존재
+	hasNext := hasMore
+	hasPrev := page.Position != nil
+	if page.IsPrev() {
+		hasNext, hasPrev = true, hasMore
+	}
+
+	if hasNext {
+		cursor := key(items[len(items)-1])
+		cursor.Direction = CURSOR_DIRECTION_NEXT
+		encoded := cursor.Encode()
+		info.NextCursor = &encoded
+	}
+	if hasPrev {
+		cursor := key(items[0])
+		cursor.Direction = CURSOR_DIRECTION_PREV
+		encoded := cursor.Encode()
+		info.PrevCursor = &encoded
+	}
+
+	return items, info
+}
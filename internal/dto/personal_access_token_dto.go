@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+const PAT_NAME_MAX_LENGTH = 100 // 토큰 이름 최대 길이
+
+// CreatePersonalAccessTokenDTO 구조체는 개인 액세스 토큰 발급을 위한 데이터 전송 객체입니다.
+// ExpiresAt을 생략하면 만료되지 않는 토큰이 발급됩니다.
+type CreatePersonalAccessTokenDTO struct {
+	Name      string     `json:"name"`
+	Scope     string     `json:"scope"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	UserID    int64      `json:"-"`
+}
+
+// Validate 함수는 개인 액세스 토큰 발급 입력 값을 확인해주는 함수입니다.
+func (d *CreatePersonalAccessTokenDTO) Validate() error {
+	d.Name = strings.TrimSpace(d.Name)
+	if d.Name == "" {
+		return apperror.ErrPersonalAccessTokenNameRequired
+	}
+	if utf8.RuneCountInString(d.Name) > PAT_NAME_MAX_LENGTH {
+		return apperror.ErrPersonalAccessTokenNameTooLong
+	}
+
+	if d.Scope != model.PAT_SCOPE_READ && d.Scope != model.PAT_SCOPE_READ_WRITE {
+		return apperror.ErrPersonalAccessTokenInvalidScope
+	}
+
+	if d.ExpiresAt != nil && !d.ExpiresAt.After(time.Now()) {
+		return apperror.ErrPersonalAccessTokenInvalidExpiry
+	}
+
+	return nil
+}
+
+// ToModel 함수는 CreatePersonalAccessTokenDTO를 model.PersonalAccessToken으로 변환합니다.
+func (d *CreatePersonalAccessTokenDTO) ToModel() *model.PersonalAccessToken {
+	return &model.PersonalAccessToken{
+		UserID:    d.UserID,
+		Name:      d.Name,
+		Scope:     d.Scope,
+		ExpiresAt: d.ExpiresAt,
+	}
+}
+
+// CreatePersonalAccessTokenResponseDTO 구조체는 개인 액세스 토큰 발급 응답을 위한 데이터 전송 객체입니다.
+// 토큰 원문(Token)은 이 응답에서 한 번만 확인할 수 있습니다.
+type CreatePersonalAccessTokenResponseDTO struct {
+	Token               string                     `json:"token"`
+	PersonalAccessToken *model.PersonalAccessToken `json:"personal_access_token"`
+}
+
+// GetPersonalAccessTokensResponseDTO 구조체는 개인 액세스 토큰 목록 조회 응답을 위한 데이터 전송 객체입니다.
+type GetPersonalAccessTokensResponseDTO struct {
+	PersonalAccessTokens []*model.PersonalAccessToken `json:"personal_access_tokens"`
+}
@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+const (
+	TAG_NAME_MAX_LENGTH = 30 // tags.name 컬럼 길이
+	DIARY_TAGS_MAX      = 10 // 일기 하나에 지정할 수 있는 최대 태그 수 ( 목록 태그 필터의 최대 개수와 같음 )
+
+	// 목록 태그 필터 조건
+	TAG_FILTER_MODE_ALL = "all" // 모든 태그가 지정된 일기
+	TAG_FILTER_MODE_ANY = "any" // 태그 중 하나라도 지정된 일기
+)
+
+// normalizeTagName 함수는 태그 이름의 앞뒤 공백과 앞에 붙은 #을 제거하고 연속된 공백을 하나로 줄입니다.
+// 목록 필터에서 쉼표로 태그를 구분하므로 쉼표는 사용할 수 없습니다.
+func normalizeTagName(name string) (string, error) {
+	name = strings.Join(strings.Fields(strings.TrimLeft(strings.TrimSpace(name), "#")), " ")
+	if name == "" {
+		return "", apperror.ErrTagNameRequired
+	}
+	if utf8.RuneCountInString(name) > TAG_NAME_MAX_LENGTH {
+		return "", apperror.ErrTagNameTooLong
+	}
+	if strings.Contains(name, ",") {
+		return "", apperror.ErrTagNameInvalid
+	}
+	return name, nil
+}
+
+// normalizeTagNames 함수는 태그 이름들을 정규화하고 대소문자 구분 없이 중복을 제거합니다. 처음 입력한 표기를 유지합니다.
+func normalizeTagNames(names []string) ([]string, error) {
+	normalized := []string{}
+	seen := map[string]bool{}
+	for _, name := range names {
+		name, err := normalizeTagName(name)
+		if err != nil {
+			return nil, err
+		}
+		key := strings.ToLower(name)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, name)
+	}
+	if len(normalized) > DIARY_TAGS_MAX {
+		return nil, apperror.ErrTagTooMany
+	}
+	return normalized, nil
+}
+
+// GetTagsResponseDTO 구조체는 태그 목록 조회 응답 DTO입니다.
+type GetTagsResponseDTO struct {
+	Tags []*model.Tag `json:"tags"`
+}
+
+// TagResponseDTO 구조체는 태그 이름 변경, 병합 응답 DTO입니다.
+type TagResponseDTO struct {
+	Tag *model.Tag `json:"tag"`
+}
+
+// UpdateTagDTO 구조체는 태그 이름 변경 요청 DTO입니다.
+type UpdateTagDTO struct {
+	ID        int64  `json:"id"`
+	CreatorID int64  `json:"creator_id"`
+	Name      string `json:"name"`
+}
+
+// Validate 함수는 UpdateTagDTO의 입력 유효성을 검사하고 태그 이름을 정규화합니다.
+func (d *UpdateTagDTO) Validate() error {
+	if d.ID <= 0 {
+		return apperror.ErrTagIDIsRequired
+	}
+
+	name, err := normalizeTagName(d.Name)
+	if err != nil {
+		return err
+	}
+	d.Name = name
+
+	return nil
+}
+
+// MergeTagDTO 구조체는 태그 병합 요청 DTO입니다. SourceID 태그의 일기를 모두 TargetID 태그로 옮기고 SourceID 태그를 삭제합니다.
+type MergeTagDTO struct {
+	SourceID  int64 `json:"source_id"`
+	TargetID  int64 `json:"target_id"`
+	CreatorID int64 `json:"creator_id"`
+}
+
+// Validate 함수는 MergeTagDTO의 입력 유효성을 검사합니다.
+func (d *MergeTagDTO) Validate() error {
+	if d.SourceID <= 0 {
+		return apperror.ErrTagIDIsRequired
+	}
+	if d.TargetID <= 0 {
+		return apperror.ErrTagMergeTargetRequired
+	}
+	if d.SourceID == d.TargetID {
+		return apperror.ErrTagMergeSameTag
+	}
+	return nil
+}
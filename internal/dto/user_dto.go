@@ -5,6 +5,7 @@ import (
 
 	"github.com/jhphon0730/dairify/internal/model"
 	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
 )
 
 // UserSignupDTO 구조체는 사용자 등록을 위한 데이터 전송 객체입니다.
@@ -33,6 +34,10 @@ func (d *UserSignupDTO) Validate() error {
 		return apperror.ErrUserSignupEmailRequired
 	}
 
+	if !utils.IsValidEmail(strings.TrimSpace(d.Email)) {
+		return apperror.ErrUserEmailInvalidFormat
+	}
+
 	return nil
 }
 
@@ -43,8 +48,12 @@ type UserSignupResponseDTO struct {
 
 // UserSigninDTO 구조체는 사용자 로그인을 위한 데이터 전송 객체입니다.
 type UserSigninDTO struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	DeviceName string `json:"device_name"`
+
+	IP        string `json:"-"` // 핸들러에서 요청 정보로 설정
+	UserAgent string `json:"-"` // 핸들러에서 요청 정보로 설정
 }
 
 // CheckIsValidInput 함수는 사용자 로그인 입력 값을 확인해주는 함수입니다.
@@ -61,13 +70,197 @@ func (d *UserSigninDTO) Validate() error {
 }
 
 // UserSigninResponseDTO 구조체는 사용자 로그인 응답을 위한 데이터 전송 객체입니다.
+// 2단계 인증을 사용하는 사용자는 토큰 대신 MFAToken을 받고, /signin/mfa/ 에서 실제 토큰으로 교환합니다.
 type UserSigninResponseDTO struct {
-	AccessToken  string      `json:"access_token"`
-	RefreshToken string      `json:"refresh_token"`
-	User         *model.User `json:"user"`
+	AccessToken  string      `json:"access_token,omitempty"`
+	RefreshToken string      `json:"refresh_token,omitempty"`
+	User         *model.User `json:"user,omitempty"`
+
+	MFARequired bool   `json:"mfa_required"`
+	MFAToken    string `json:"mfa_token,omitempty"`
+}
+
+// UserSigninMFADTO 구조체는 로그인 2단계 인증을 위한 데이터 전송 객체입니다.
+// Code(인증 앱 코드)와 RecoveryCode(복구 코드) 중 하나를 입력합니다.
+type UserSigninMFADTO struct {
+	MFAToken     string `json:"mfa_token"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+
+	IP        string `json:"-"` // 핸들러에서 요청 정보로 설정
+	UserAgent string `json:"-"` // 핸들러에서 요청 정보로 설정
+}
+
+// Validate 함수는 로그인 2단계 인증 입력 값을 확인해주는 함수입니다.
+func (d *UserSigninMFADTO) Validate() error {
+	if strings.TrimSpace(d.MFAToken) == "" {
+		return apperror.ErrMFATokenRequired
+	}
+
+	if strings.TrimSpace(d.Code) == "" && strings.TrimSpace(d.RecoveryCode) == "" {
+		return apperror.ErrMFACodeRequired
+	}
+
+	return nil
 }
 
 // UserProfileResponseDTO 구조체는 사용자 프로필 응답을 위한 데이터 전송 객체입니다.
 type UserProfileResponseDTO struct {
 	User *model.User `json:"user"`
 }
+
+// UserRefreshDTO 구조체는 토큰 재발급 요청을 위한 데이터 전송 객체입니다.
+type UserRefreshDTO struct {
+	RefreshToken string `json:"refresh_token"`
+
+	IP        string `json:"-"` // 핸들러에서 요청 정보로 설정
+	UserAgent string `json:"-"` // 핸들러에서 요청 정보로 설정
+}
+
+// Validate 함수는 토큰 재발급 입력 값을 확인해주는 함수입니다.
+func (d *UserRefreshDTO) Validate() error {
+	if strings.TrimSpace(d.RefreshToken) == "" {
+		return apperror.ErrAuthRefreshTokenRequired
+	}
+
+	return nil
+}
+
+// UserRefreshResponseDTO 구조체는 토큰 재발급 응답을 위한 데이터 전송 객체입니다.
+type UserRefreshResponseDTO struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// UserPasswordResetRequestDTO 구조체는 비밀번호 재설정 메일 요청을 위한 데이터 전송 객체입니다.
+type UserPasswordResetRequestDTO struct {
+	Email string `json:"email"`
+}
+
+// Validate 함수는 비밀번호 재설정 메일 요청 입력 값을 확인해주는 함수입니다.
+func (d *UserPasswordResetRequestDTO) Validate() error {
+	if strings.TrimSpace(d.Email) == "" {
+		return apperror.ErrUserPasswordResetEmailRequired
+	}
+
+	return nil
+}
+
+// UserPasswordResetConfirmDTO 구조체는 비밀번호 재설정 확정을 위한 데이터 전송 객체입니다.
+type UserPasswordResetConfirmDTO struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+// Validate 함수는 비밀번호 재설정 확정 입력 값을 확인해주는 함수입니다.
+func (d *UserPasswordResetConfirmDTO) Validate() error {
+	if strings.TrimSpace(d.Token) == "" {
+		return apperror.ErrUserPasswordResetTokenRequired
+	}
+
+	if strings.TrimSpace(d.NewPassword) == "" {
+		return apperror.ErrUserPasswordNewRequired
+	}
+
+	return nil
+}
+
+// UserVerifyEmailDTO 구조체는 이메일 인증 요청을 위한 데이터 전송 객체입니다.
+type UserVerifyEmailDTO struct {
+	Token string `json:"token"`
+}
+
+// Validate 함수는 이메일 인증 입력 값을 확인해주는 함수입니다.
+func (d *UserVerifyEmailDTO) Validate() error {
+	if strings.TrimSpace(d.Token) == "" {
+		return apperror.ErrUserEmailVerifyTokenRequired
+	}
+
+	return nil
+}
+
+// UserResendVerificationDTO 구조체는 인증 메일 재발송 요청을 위한 데이터 전송 객체입니다.
+type UserResendVerificationDTO struct {
+	Email string `json:"email"`
+}
+
+// Validate 함수는 인증 메일 재발송 입력 값을 확인해주는 함수입니다.
+func (d *UserResendVerificationDTO) Validate() error {
+	if strings.TrimSpace(d.Email) == "" {
+		return apperror.ErrUserSignupEmailRequired
+	}
+
+	return nil
+}
+
+// UserUpdateProfileDTO 구조체는 프로필 수정 요청을 위한 데이터 전송 객체입니다.
+// 값이 없는(nil) 필드는 변경하지 않습니다.
+type UserUpdateProfileDTO struct {
+	Nickname           *string `json:"nickname"`
+	Email              *string `json:"email"`
+	Timezone           *string `json:"timezone"`             // IANA 시간대 이름, 빈 문자열이면 서버 기본 시간대 사용
+	DiaryRevisionLimit *int    `json:"diary_revision_limit"` // 일기별로 보관할 최대 수정 기록 수 ( 1~200 )
+	CurrentPassword    string  `json:"current_password"`     // 이메일 변경 시 필수 ( 비밀번호가 없는 외부 로그인 계정은 생략 )
+}
+
+// Validate 함수는 프로필 수정 입력 값을 확인해주는 함수입니다.
+func (d *UserUpdateProfileDTO) Validate() error {
+	if d.Nickname == nil && d.Email == nil && d.Timezone == nil && d.DiaryRevisionLimit == nil {
+		return apperror.ErrUserProfileNothingToUpdate
+	}
+
+	if d.Nickname != nil && strings.TrimSpace(*d.Nickname) == "" {
+		return apperror.ErrUserProfileNicknameRequired
+	}
+
+	if d.Email != nil && strings.TrimSpace(*d.Email) == "" {
+		return apperror.ErrUserProfileEmailRequired
+	}
+
+	if d.Email != nil && !utils.IsValidEmail(strings.TrimSpace(*d.Email)) {
+		return apperror.ErrUserEmailInvalidFormat
+	}
+
+	if d.Timezone != nil && strings.TrimSpace(*d.Timezone) != "" {
+		if _, err := utils.LoadTimezone(*d.Timezone); err != nil {
+			return err
+		}
+	}
+
+	if d.DiaryRevisionLimit != nil && (*d.DiaryRevisionLimit < DIARY_REVISION_LIMIT_MIN || *d.DiaryRevisionLimit > DIARY_REVISION_LIMIT_MAX) {
+		return apperror.ErrUserProfileInvalidRevisionLimit
+	}
+
+	return nil
+}
+
+// UserSessionsResponseDTO 구조체는 세션 목록 조회 응답을 위한 데이터 전송 객체입니다.
+type UserSessionsResponseDTO struct {
+	Sessions []*model.Session `json:"sessions"`
+}
+
+// UserChangePasswordDTO 구조체는 비밀번호 변경 요청을 위한 데이터 전송 객체입니다.
+// 비밀번호가 없는 외부 로그인 계정은 현재 비밀번호 없이 새 비밀번호를 설정하므로, 현재 비밀번호 필수 여부는 서비스에서 확인합니다.
+type UserChangePasswordDTO struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// Validate 함수는 비밀번호 변경 입력 값을 확인해주는 함수입니다.
+func (d *UserChangePasswordDTO) Validate() error {
+	if strings.TrimSpace(d.NewPassword) == "" {
+		return apperror.ErrUserPasswordNewRequired
+	}
+
+	if d.CurrentPassword == d.NewPassword {
+		return apperror.ErrUserPasswordSameAsCurrent
+	}
+
+	return nil
+}
+
+// UserChangePasswordResponseDTO 구조체는 비밀번호 변경 응답을 위한 데이터 전송 객체입니다.
+type UserChangePasswordResponseDTO struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/middleware"
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/internal/service"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// AccountHandler 인터페이스는 계정 삭제 및 내보내기 관련 핸들러의 메서드를 정의합니다.
+type AccountHandler interface {
+	DeleteAccount(w http.ResponseWriter, r *http.Request)
+	CancelDeletion(w http.ResponseWriter, r *http.Request)
+	ExportAccount(w http.ResponseWriter, r *http.Request)
+}
+
+// accountHandler 구조체는 AccountHandler 인터페이스를 구현합니다.
+type accountHandler struct {
+	accountService service.AccountService
+}
+
+// NewAccountHandler 함수는 AccountHandler 인터페이스의 구현체를 반환합니다.
+func NewAccountHandler(accountService service.AccountService) AccountHandler {
+	return &accountHandler{
+		accountService: accountService,
+	}
+}
+
+/* DeleteAccount 함수는 비밀번호를 확인하고 계정 삭제를 예약하는 핸들러입니다. */
+func (h *accountHandler) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	sessionID, ok := middleware.GetSessionIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.AccountDeleteDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user, status, err := h.accountService.ScheduleDeletion(r.Context(), userID, sessionID, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.AccountDeleteResponseDTO{
+		DeletionScheduledAt: user.DeletionScheduledAt,
+	}
+	response.Success(w, status, "Account deletion scheduled successfully", res)
+}
+
+/* CancelDeletion 함수는 예약된 계정 삭제를 취소하는 핸들러입니다. */
+func (h *accountHandler) CancelDeletion(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	status, err := h.accountService.CancelDeletion(r.Context(), userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Account deletion cancelled successfully", nil)
+}
+
+/* ExportAccount 함수는 사용자가 소유한 모든 데이터를 내보내는 핸들러입니다. */
+func (h *accountHandler) ExportAccount(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	export, status, err := h.accountService.ExportAccount(r.Context(), userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Account exported successfully", export)
+}
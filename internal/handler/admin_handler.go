@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/middleware"
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/internal/service"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+// AdminHandler 인터페이스는 관리자용 사용자 관리 핸들러의 메서드를 정의합니다.
+type AdminHandler interface {
+	ListUsers(w http.ResponseWriter, r *http.Request)
+	GetUser(w http.ResponseWriter, r *http.Request)
+	DisableUser(w http.ResponseWriter, r *http.Request)
+	EnableUser(w http.ResponseWriter, r *http.Request)
+	RevokeUserSessions(w http.ResponseWriter, r *http.Request)
+	ListSigninLockouts(w http.ResponseWriter, r *http.Request)
+}
+
+// adminHandler 구조체는 AdminHandler 인터페이스를 구현합니다.
+type adminHandler struct {
+	adminService service.AdminService
+}
+
+// NewAdminHandler 함수는 AdminHandler 인터페이스의 구현체를 반환합니다.
+func NewAdminHandler(adminService service.AdminService) AdminHandler {
+	return &adminHandler{
+		adminService: adminService,
+	}
+}
+
+/* ListUsers 함수는 사용자 목록을 조회하거나 검색하는 핸들러입니다. (q, status, page, limit) */
+func (h *adminHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	params := r.URL.Query()
+	page, limit, err := parsePageParams(params)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	inp := dto.AdminListUsersDTO{
+		Query:  params.Get("q"),
+		Status: params.Get("status"),
+		Page:   page,
+		Limit:  limit,
+	}
+
+	res, status, err := h.adminService.ListUsers(r.Context(), inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Users retrieved successfully", res)
+}
+
+/* GetUser 함수는 사용자 정보와 일기 수, 저장 공간 사용량을 조회하는 핸들러입니다. */
+func (h *adminHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	// 경로 변수에서 사용자 id 추출 (예: /users/{id}/)
+	id := r.PathValue("id")
+	if id == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrAdminUserIDRequired.Error())
+		return
+	}
+
+	user, status, err := h.adminService.GetUser(r.Context(), utils.InterfaceToInt64(id))
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.AdminUserResponseDTO{
+		User: user,
+	}
+	response.Success(w, status, "User retrieved successfully", res)
+}
+
+/* DisableUser 함수는 사용자 계정을 비활성화하고 모든 세션을 폐기하는 핸들러입니다. */
+func (h *adminHandler) DisableUser(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	adminID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	id := r.PathValue("id")
+	if id == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrAdminUserIDRequired.Error())
+		return
+	}
+
+	user, status, err := h.adminService.DisableUser(r.Context(), adminID, utils.InterfaceToInt64(id))
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.AdminUserResponseDTO{
+		User: user,
+	}
+	response.Success(w, status, "User disabled successfully", res)
+}
+
+/* EnableUser 함수는 비활성화된 사용자 계정을 다시 활성화하는 핸들러입니다. */
+func (h *adminHandler) EnableUser(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	adminID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	id := r.PathValue("id")
+	if id == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrAdminUserIDRequired.Error())
+		return
+	}
+
+	user, status, err := h.adminService.EnableUser(r.Context(), adminID, utils.InterfaceToInt64(id))
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.AdminUserResponseDTO{
+		User: user,
+	}
+	response.Success(w, status, "User enabled successfully", res)
+}
+
+/* RevokeUserSessions 함수는 사용자의 모든 로그인 세션을 강제로 폐기하는 핸들러입니다. */
+func (h *adminHandler) RevokeUserSessions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	adminID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	id := r.PathValue("id")
+	if id == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrAdminUserIDRequired.Error())
+		return
+	}
+
+	revokedCount, status, err := h.adminService.RevokeUserSessions(r.Context(), adminID, utils.InterfaceToInt64(id))
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.AdminRevokeSessionsResponseDTO{
+		RevokedCount: revokedCount,
+	}
+	response.Success(w, status, "User sessions revoked successfully", res)
+}
+
+/* ListSigninLockouts 함수는 로그인 잠금 기록을 조회하는 핸들러입니다. (scope, identifier, user_id, active, page, limit) */
+func (h *adminHandler) ListSigninLockouts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	params := r.URL.Query()
+	page, limit, err := parsePageParams(params)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	inp := dto.AdminListSigninLockoutsDTO{
+		Scope:      params.Get("scope"),
+		Identifier: params.Get("identifier"),
+		Active:     params.Get("active") == "true",
+		Page:       page,
+		Limit:      limit,
+	}
+
+	// 사용자 ID를 지정하면 해당 사용자의 아이디 잠금 기록만 조회
+	if v := params.Get("user_id"); v != "" {
+		userID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, apperror.ErrAdminInvalidUserID.Error())
+			return
+		}
+		inp.UserID = &userID
+	}
+
+	res, status, err := h.adminService.ListSigninLockouts(r.Context(), inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Signin lockouts retrieved successfully", res)
+}
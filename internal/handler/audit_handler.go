@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/middleware"
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/internal/service"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// AuditHandler 인터페이스는 보안 감사 로그 조회 핸들러의 메서드를 정의합니다.
+type AuditHandler interface {
+	GetSecurityEvents(w http.ResponseWriter, r *http.Request)
+	ListSecurityEvents(w http.ResponseWriter, r *http.Request)
+}
+
+// auditHandler 구조체는 AuditHandler 인터페이스를 구현합니다.
+type auditHandler struct {
+	auditService service.AuditService
+}
+
+// NewAuditHandler 함수는 AuditHandler 인터페이스의 구현체를 반환합니다.
+func NewAuditHandler(auditService service.AuditService) AuditHandler {
+	return &auditHandler{
+		auditService: auditService,
+	}
+}
+
+/* GetSecurityEvents 함수는 사용자 본인의 보안 기록을 조회하는 핸들러입니다. (type, page, limit) */
+func (h *auditHandler) GetSecurityEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	params := r.URL.Query()
+	page, limit, err := parsePageParams(params)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	inp := dto.AuditEventListDTO{
+		Type:  params.Get("type"),
+		Page:  page,
+		Limit: limit,
+	}
+
+	res, status, err := h.auditService.ListUserEvents(r.Context(), userID, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Security events retrieved successfully", res)
+}
+
+/* ListSecurityEvents 함수는 관리자가 전체 사용자의 보안 기록을 조회하는 핸들러입니다. (user_id, type, page, limit) */
+func (h *auditHandler) ListSecurityEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	params := r.URL.Query()
+	page, limit, err := parsePageParams(params)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	inp := dto.AuditEventListDTO{
+		Type:  params.Get("type"),
+		Page:  page,
+		Limit: limit,
+	}
+
+	// 사용자 ID를 지정하면 해당 사용자의 기록만 조회
+	if v := params.Get("user_id"); v != "" {
+		userID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, apperror.ErrAuditInvalidUserID.Error())
+			return
+		}
+		inp.UserID = &userID
+	}
+
+	res, status, err := h.auditService.ListEvents(r.Context(), inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Security events retrieved successfully", res)
+}
@@ -78,14 +78,16 @@ func (h *categoryHandler) GetCategoriesByCreatorID(w http.ResponseWriter, r *htt
 		return
 	}
 
-	categories, statusCode, err := h.categoryService.GetCategoriesByCreatorID(r.Context(), userID)
+	page, err := parseCursorPageParams(r.URL.Query())
 	if err != nil {
-		response.Error(w, statusCode, err.Error())
+		response.Error(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	res := dto.GetCategoriesResponseDTO{
-		Categories: categories,
+	res, statusCode, err := h.categoryService.GetCategoriesByCreatorID(r.Context(), userID, page)
+	if err != nil {
+		response.Error(w, statusCode, err.Error())
+		return
 	}
 
 	response.Success(w, http.StatusOK, "Categories retrieved successfully", res)
@@ -2,11 +2,16 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/jhphon0730/dairify/internal/dto"
 	"github.com/jhphon0730/dairify/internal/middleware"
 	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/internal/searchquery"
 	"github.com/jhphon0730/dairify/internal/service"
 	"github.com/jhphon0730/dairify/pkg/apperror"
 	"github.com/jhphon0730/dairify/pkg/utils"
@@ -21,7 +26,20 @@ type DiaryHandler interface {
 	CreateDiary(w http.ResponseWriter, r *http.Request)
 	DeleteDiary(w http.ResponseWriter, r *http.Request)
 	UpdateDiary(w http.ResponseWriter, r *http.Request)
+	PatchDiary(w http.ResponseWriter, r *http.Request)
 	UploadDiaryImage(w http.ResponseWriter, r *http.Request)
+	SearchDiaries(w http.ResponseWriter, r *http.Request)
+	SuggestSearch(w http.ResponseWriter, r *http.Request)
+	GetDiaryCalendar(w http.ResponseWriter, r *http.Request)
+	ListMoods(w http.ResponseWriter, r *http.Request)
+	GetMoodStats(w http.ResponseWriter, r *http.Request)
+	ListDiaryRevisions(w http.ResponseWriter, r *http.Request)
+	GetDiaryRevision(w http.ResponseWriter, r *http.Request)
+	DiffDiaryRevisions(w http.ResponseWriter, r *http.Request)
+	RestoreDiaryRevision(w http.ResponseWriter, r *http.Request)
+	GetTrashedDiaries(w http.ResponseWriter, r *http.Request)
+	RestoreDiary(w http.ResponseWriter, r *http.Request)
+	PurgeDiary(w http.ResponseWriter, r *http.Request)
 }
 
 // diaryHandler 구조체는 DiaryHandler 인터페이스를 구현합니다.
@@ -70,11 +88,18 @@ func (h *diaryHandler) GetDiaryByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 클라이언트가 가진 버전이 최신이면 본문 없이 304 응답
+	w.Header().Set("ETag", diary.ETag())
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" && utils.MatchIfNoneMatch(ifNoneMatch, diary.ETag()) {
+		response.NotModified(w)
+		return
+	}
+
 	res := dto.GetDiaryByIDResponseDTO{Diary: diary}
 	response.Success(w, status, "Diary retrieved successfully", res)
 }
 
-// GetDiariesByCreatorID 함수는 주어진 생성자 ID로 일기 목록을 조회하는 HTTP 핸들러입니다.
+// GetDiariesByCreatorID 함수는 주어진 생성자 ID로 일기 목록을 조회하는 HTTP 핸들러입니다. (limit, cursor, include_total, category_id, title, mood, tags, tag_mode, from, to, q)
 func (h *diaryHandler) GetDiariesByCreatorID(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
@@ -88,14 +113,33 @@ func (h *diaryHandler) GetDiariesByCreatorID(w http.ResponseWriter, r *http.Requ
 	}
 
 	params := r.URL.Query()
-	diaries, status, err := h.diaryService.GetDiariesByCreatorID(r.Context(), userID, params)
+	page, err := parseCursorPageParams(params)
 	if err != nil {
-		response.Error(w, status, err.Error())
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	categoryID, err := parseCategoryIDParam(params)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	res := dto.GetDiariesByCreatorIDResponseDTO{
-		Diaries: diaries,
+	filter := dto.DiaryListFilterDTO{
+		CategoryID: categoryID,
+		Title:      params.Get("title"),
+		Moods:      parseListParams(params, "mood"),
+		Tags:       parseListParams(params, "tags"),
+		TagMode:    params.Get("tag_mode"),
+		From:       params.Get("from"),
+		To:         params.Get("to"),
+		Query:      params.Get("q"),
+	}
+
+	res, status, err := h.diaryService.GetDiariesByCreatorID(r.Context(), userID, filter, page)
+	if err != nil {
+		searchQueryError(w, status, err)
+		return
 	}
 
 	response.Success(w, status, "Diary list retrieved successfully", res)
@@ -166,7 +210,8 @@ func (h *diaryHandler) DeleteDiary(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, status, "Diary deleted successfully", nil)
 }
 
-// UpdateDiary 함수는 일기를 수정하는 HTTP 핸들러입니다.
+// UpdateDiary 함수는 일기를 수정하는 HTTP 핸들러입니다. (If-Match)
+// If-Match 헤더가 현재 ETag와 다르면 412 상태와 함께 서버의 현재 일기를 details로 반환합니다.
 func (h *diaryHandler) UpdateDiary(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPut {
 		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
@@ -196,13 +241,64 @@ func (h *diaryHandler) UpdateDiary(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	status, err := h.diaryService.UpdateDiary(r.Context(), updateDiaryDTO, diaryID, userID)
+	updateDiaryDTO.IfMatch = r.Header.Get("If-Match")
+	diary, status, err := h.diaryService.UpdateDiary(r.Context(), updateDiaryDTO, diaryID, userID)
+	if err != nil {
+		if status == http.StatusPreconditionFailed && diary != nil {
+			w.Header().Set("ETag", diary.ETag())
+			response.ErrorWithDetails(w, status, err.Error(), dto.UpdateDiaryResponseDTO{Diary: diary})
+			return
+		}
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	w.Header().Set("ETag", diary.ETag())
+	res := dto.UpdateDiaryResponseDTO{Diary: diary}
+	response.Success(w, status, "Diary updated successfully", res)
+}
+
+// PatchDiary 함수는 JSON Merge Patch 형식으로 일기의 일부 항목을 수정하는 HTTP 핸들러입니다. (If-Match)
+// 본문에 없는 항목은 유지하고 null로 보낸 항목은 비우며, If-Match 헤더가 현재 ETag와 다르면 412 상태와 함께 서버의 현재 일기를 details로 반환합니다.
+func (h *diaryHandler) PatchDiary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPatch {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	var patchDiaryDTO dto.PatchDiaryDTO
+	if err := json.NewDecoder(r.Body).Decode(&patchDiaryDTO); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	diaryID := utils.InterfaceToInt64(r.PathValue("id"))
+	if diaryID <= 0 {
+		response.Error(w, http.StatusBadRequest, apperror.ErrDiaryNotFound.Error())
+		return
+	}
+
+	patchDiaryDTO.IfMatch = r.Header.Get("If-Match")
+	diary, status, err := h.diaryService.PatchDiary(r.Context(), patchDiaryDTO, diaryID, userID)
 	if err != nil {
+		if status == http.StatusPreconditionFailed && diary != nil {
+			w.Header().Set("ETag", diary.ETag())
+			response.ErrorWithDetails(w, status, err.Error(), dto.UpdateDiaryResponseDTO{Diary: diary})
+			return
+		}
 		response.Error(w, status, err.Error())
 		return
 	}
 
-	response.Success(w, status, "Diary updated successfully", nil)
+	w.Header().Set("ETag", diary.ETag())
+	res := dto.UpdateDiaryResponseDTO{Diary: diary}
+	response.Success(w, status, "Diary updated successfully", res)
 }
 
 // UploadDiaryImage 함수는 일기 이미지 업로드를 처리하는 HTTP 핸들러입니다.
@@ -260,3 +356,188 @@ func (h *diaryHandler) UploadDiaryImage(w http.ResponseWriter, r *http.Request)
 
 	response.Success(w, status, "Diary images uploaded successfully", res)
 }
+
+// SearchDiaries 함수는 일기 제목과 본문을 검색하여 관련도 순으로 반환하는 HTTP 핸들러입니다. (q, category_id, page, limit)
+// q는 검색식이며 category:, before:, after:, has: 연산자와 "구문", -제외 검색어를 사용할 수 있습니다.
+func (h *diaryHandler) SearchDiaries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	params := r.URL.Query()
+	page, limit, err := parsePageParams(params)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	categoryID, err := parseCategoryIDParam(params)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	inp := dto.SearchDiariesDTO{
+		Query:      params.Get("q"),
+		CategoryID: categoryID,
+		Page:       page,
+		Limit:      limit,
+	}
+
+	res, status, err := h.diaryService.SearchDiaries(r.Context(), userID, inp)
+	if err != nil {
+		searchQueryError(w, status, err)
+		return
+	}
+
+	response.Success(w, status, "Diary search results retrieved successfully", res)
+}
+
+// SuggestSearch 함수는 입력 중인 검색식의 자동 완성 항목을 추천하는 HTTP 핸들러입니다. (q)
+func (h *diaryHandler) SuggestSearch(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	res, status, err := h.diaryService.SuggestSearch(r.Context(), userID, r.URL.Query().Get("q"))
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Search suggestions retrieved successfully", res)
+}
+
+// GetDiaryCalendar 함수는 한 달의 날짜별 일기 요약을 사용자 시간대 기준으로 조회하는 HTTP 핸들러입니다. (year, month)
+func (h *diaryHandler) GetDiaryCalendar(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	params := r.URL.Query()
+	inp := dto.DiaryCalendarDTO{}
+	if v := params.Get("year"); v != "" {
+		year, err := strconv.Atoi(v)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, apperror.ErrDiaryCalendarInvalidYear.Error())
+			return
+		}
+		inp.Year = year
+	}
+	if v := params.Get("month"); v != "" {
+		month, err := strconv.Atoi(v)
+		if err != nil {
+			response.Error(w, http.StatusBadRequest, apperror.ErrDiaryCalendarInvalidMonth.Error())
+			return
+		}
+		inp.Month = month
+	}
+
+	res, status, err := h.diaryService.GetDiaryCalendar(r.Context(), userID, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Diary calendar retrieved successfully", res)
+}
+
+// ListMoods 함수는 일기에 지정할 수 있는 감정 목록을 조회하는 HTTP 핸들러입니다.
+func (h *diaryHandler) ListMoods(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	moods, status, err := h.diaryService.ListMoods(r.Context())
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.ListMoodsResponseDTO{Moods: moods}
+	response.Success(w, status, "Moods retrieved successfully", res)
+}
+
+// GetMoodStats 함수는 기간별 감정 분포를 사용자 시간대 기준으로 조회하는 HTTP 핸들러입니다. (from, to, interval)
+func (h *diaryHandler) GetMoodStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	params := r.URL.Query()
+	inp := dto.MoodStatsDTO{
+		From:     params.Get("from"),
+		To:       params.Get("to"),
+		Interval: params.Get("interval"),
+	}
+
+	res, status, err := h.diaryService.GetMoodStats(r.Context(), userID, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Mood statistics retrieved successfully", res)
+}
+
+// parseListParams 함수는 쉼표로 구분하거나 여러 번 지정한 쿼리 문자열 값을 목록으로 변환합니다. (예: mood=happy,calm 또는 mood=happy&mood=calm)
+func parseListParams(params url.Values, key string) []string {
+	values := []string{}
+	for _, v := range params[key] {
+		values = append(values, strings.Split(v, ",")...)
+	}
+	return values
+}
+
+// parseCategoryIDParam 함수는 쿼리 문자열의 category_id 값을 정수로 변환합니다. 생략되면 nil을 반환합니다.
+func parseCategoryIDParam(params url.Values) (*int64, error) {
+	v := params.Get("category_id")
+	if v == "" {
+		return nil, nil
+	}
+
+	categoryID, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || categoryID < 1 {
+		return nil, apperror.ErrDiaryInvalidCategoryID
+	}
+	return &categoryID, nil
+}
+
+// searchQueryError 함수는 검색식 해석 오류이면 오류 위치를 포함하여, 아니면 일반 에러 응답을 반환합니다.
+func searchQueryError(w http.ResponseWriter, status int, err error) {
+	var parseErr *searchquery.ParseError
+	if errors.As(err, &parseErr) {
+		response.ErrorWithDetails(w, status, err.Error(), parseErr)
+		return
+	}
+	response.Error(w, status, err.Error())
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/middleware"
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+// parseRevisionNumber 함수는 수정 기록 번호 문자열을 정수로 변환합니다. 생략되면 0을 반환합니다.
+func parseRevisionNumber(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	revisionNumber, err := strconv.Atoi(value)
+	if err != nil || revisionNumber <= 0 {
+		return 0, apperror.ErrDiaryRevisionInvalidNumber
+	}
+	return revisionNumber, nil
+}
+
+// ListDiaryRevisions 함수는 일기의 수정 기록 목록을 최신순으로 조회하는 HTTP 핸들러입니다.
+func (h *diaryHandler) ListDiaryRevisions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// 경로 변수에서 일기 id 추출 (예: /revisions/{id}/)
+	diaryID := utils.InterfaceToInt64(r.PathValue("id"))
+	if diaryID == 0 {
+		response.Error(w, http.StatusBadRequest, apperror.ErrDiaryNotFound.Error())
+		return
+	}
+
+	res, status, err := h.diaryService.ListDiaryRevisions(r.Context(), diaryID, userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Diary revisions retrieved successfully", res)
+}
+
+// GetDiaryRevision 함수는 일기의 수정 기록 하나를 내용과 함께 조회하는 HTTP 핸들러입니다.
+func (h *diaryHandler) GetDiaryRevision(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// 경로 변수에서 일기 id와 수정 기록 번호 추출 (예: /revisions/{id}/{revision}/, /revisions/restore/{id}/{revision}/)
+	diaryID := utils.InterfaceToInt64(r.PathValue("id"))
+	if diaryID == 0 {
+		response.Error(w, http.StatusBadRequest, apperror.ErrDiaryNotFound.Error())
+		return
+	}
+	revisionNumber, err := parseRevisionNumber(r.PathValue("revision"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	revision, status, err := h.diaryService.GetDiaryRevision(r.Context(), diaryID, revisionNumber, userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.DiaryRevisionResponseDTO{Revision: revision}
+	response.Success(w, status, "Diary revision retrieved successfully", res)
+}
+
+// DiffDiaryRevisions 함수는 두 수정 기록의 제목과 내용을 비교하는 HTTP 핸들러입니다. (from, to, mode)
+func (h *diaryHandler) DiffDiaryRevisions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	diaryID := utils.InterfaceToInt64(r.PathValue("id"))
+	if diaryID == 0 {
+		response.Error(w, http.StatusBadRequest, apperror.ErrDiaryNotFound.Error())
+		return
+	}
+
+	params := r.URL.Query()
+	from, err := parseRevisionNumber(params.Get("from"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	to, err := parseRevisionNumber(params.Get("to"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	inp := dto.DiaryRevisionDiffDTO{
+		From: from,
+		To:   to,
+		Mode: params.Get("mode"),
+	}
+
+	res, status, err := h.diaryService.DiffDiaryRevisions(r.Context(), diaryID, userID, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Diary revision diff retrieved successfully", res)
+}
+
+// RestoreDiaryRevision 함수는 이전 수정 기록의 제목과 내용을 새 수정 기록으로 복원하는 HTTP 핸들러입니다.
+func (h *diaryHandler) RestoreDiaryRevision(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	diaryID := utils.InterfaceToInt64(r.PathValue("id"))
+	if diaryID == 0 {
+		response.Error(w, http.StatusBadRequest, apperror.ErrDiaryNotFound.Error())
+		return
+	}
+	revisionNumber, err := parseRevisionNumber(r.PathValue("revision"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	diary, status, err := h.diaryService.RestoreDiaryRevision(r.Context(), diaryID, revisionNumber, userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	w.Header().Set("ETag", diary.ETag())
+	res := dto.GetDiaryByIDResponseDTO{Diary: diary}
+	response.Success(w, status, "Diary revision restored successfully", res)
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/middleware"
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+// GetTrashedDiaries 함수는 휴지통에 있는 일기 목록을 조회하는 HTTP 핸들러입니다. (limit, cursor, include_total)
+func (h *diaryHandler) GetTrashedDiaries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	page, err := parseCursorPageParams(r.URL.Query())
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	res, status, err := h.diaryService.GetTrashedDiaries(r.Context(), userID, page)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Trashed diaries retrieved successfully", res)
+}
+
+// RestoreDiary 함수는 휴지통에 있는 일기를 복원하는 HTTP 핸들러입니다.
+func (h *diaryHandler) RestoreDiary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// 경로 변수에서 일기 id 추출 (예: /restore/{id}/)
+	diaryID := utils.InterfaceToInt64(r.PathValue("id"))
+	if diaryID <= 0 {
+		response.Error(w, http.StatusBadRequest, apperror.ErrDiaryNotFoundInTrash.Error())
+		return
+	}
+
+	diary, status, err := h.diaryService.RestoreDiary(r.Context(), diaryID, userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	w.Header().Set("ETag", diary.ETag())
+	res := dto.GetDiaryByIDResponseDTO{Diary: diary}
+	response.Success(w, status, "Diary restored successfully", res)
+}
+
+// PurgeDiary 함수는 휴지통에 있는 일기를 이미지와 함께 영구 삭제하는 HTTP 핸들러입니다.
+func (h *diaryHandler) PurgeDiary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// 경로 변수에서 일기 id 추출 (예: /purge/{id}/)
+	diaryID := utils.InterfaceToInt64(r.PathValue("id"))
+	if diaryID <= 0 {
+		response.Error(w, http.StatusBadRequest, apperror.ErrDiaryNotFoundInTrash.Error())
+		return
+	}
+
+	status, err := h.diaryService.PurgeDiary(r.Context(), diaryID, userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Diary purged successfully", nil)
+}
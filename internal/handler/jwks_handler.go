@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/auth"
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// JWKS_CACHE_CONTROL은 다른 서비스가 공개 키를 캐시할 수 있는 시간입니다. 키 교체 시 retiring 키를 이보다 오래 유지해야 합니다.
+const JWKS_CACHE_CONTROL = "public, max-age=3600"
+
+// JWKSHandler 인터페이스는 JWT 공개 키 조회 핸들러의 메서드를 정의합니다.
+type JWKSHandler interface {
+	GetJWKS(w http.ResponseWriter, r *http.Request)
+}
+
+// jwksHandler 구조체는 JWKSHandler 인터페이스를 구현합니다.
+type jwksHandler struct{}
+
+// NewJWKSHandler 함수는 JWKSHandler 인터페이스의 구현체를 반환합니다.
+func NewJWKSHandler() JWKSHandler {
+	return &jwksHandler{}
+}
+
+/* GetJWKS 함수는 토큰 검증용 공개 키를 JWK Set 형식으로 반환하는 핸들러입니다. */
+// 표준 형식을 따라야 하므로 공통 응답 포맷으로 감싸지 않습니다.
+func (h *jwksHandler) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	keyring, err := auth.LoadKeyring()
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, apperror.ErrInternalServerError.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", JWKS_CACHE_CONTROL)
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(keyring.PublicJWKS())
+}
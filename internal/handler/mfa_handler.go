@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/middleware"
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/internal/service"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// MFAHandler 인터페이스는 2단계 인증 관련 핸들러의 메서드를 정의합니다.
+type MFAHandler interface {
+	Status(w http.ResponseWriter, r *http.Request)
+	SetupTOTP(w http.ResponseWriter, r *http.Request)
+	ConfirmTOTP(w http.ResponseWriter, r *http.Request)
+	DisableTOTP(w http.ResponseWriter, r *http.Request)
+	RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request)
+}
+
+// mfaHandler 구조체는 MFAHandler 인터페이스를 구현합니다.
+type mfaHandler struct {
+	mfaService service.MFAService
+}
+
+// NewMFAHandler 함수는 MFAHandler 인터페이스의 구현체를 반환합니다.
+func NewMFAHandler(mfaService service.MFAService) MFAHandler {
+	return &mfaHandler{
+		mfaService: mfaService,
+	}
+}
+
+/* Status 함수는 2단계 인증 상태를 조회하는 핸들러입니다. */
+func (h *mfaHandler) Status(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	res, status, err := h.mfaService.Status(r.Context(), userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "MFA status retrieved successfully", res)
+}
+
+/* SetupTOTP 함수는 TOTP 등록을 시작하는 핸들러입니다. */
+func (h *mfaHandler) SetupTOTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	res, status, err := h.mfaService.SetupTOTP(r.Context(), userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "TOTP setup started successfully", res)
+}
+
+/* ConfirmTOTP 함수는 첫 코드를 확인하여 TOTP를 활성화하는 핸들러입니다. */
+func (h *mfaHandler) ConfirmTOTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.MFATOTPConfirmDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	codes, status, err := h.mfaService.ConfirmTOTP(r.Context(), userID, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.MFARecoveryCodesResponseDTO{
+		RecoveryCodes: codes,
+	}
+	response.Success(w, status, "TOTP enabled successfully", res)
+}
+
+/* DisableTOTP 함수는 TOTP를 해제하는 핸들러입니다. */
+func (h *mfaHandler) DisableTOTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.MFATOTPDisableDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	status, err := h.mfaService.DisableTOTP(r.Context(), userID, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "TOTP disabled successfully", nil)
+}
+
+/* RegenerateRecoveryCodes 함수는 복구 코드를 새로 발급하는 핸들러입니다. */
+func (h *mfaHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.MFARecoveryCodesRegenerateDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	codes, status, err := h.mfaService.RegenerateRecoveryCodes(r.Context(), userID, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.MFARecoveryCodesResponseDTO{
+		RecoveryCodes: codes,
+	}
+	response.Success(w, status, "Recovery codes regenerated successfully", res)
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/middleware"
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/internal/service"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+// OIDCHandler 인터페이스는 외부 로그인 및 외부 계정 연결 관련 핸들러의 메서드를 정의합니다.
+type OIDCHandler interface {
+	Authorize(w http.ResponseWriter, r *http.Request)
+	Callback(w http.ResponseWriter, r *http.Request)
+	GetIdentities(w http.ResponseWriter, r *http.Request)
+	StartLink(w http.ResponseWriter, r *http.Request)
+	LinkCallback(w http.ResponseWriter, r *http.Request)
+	Unlink(w http.ResponseWriter, r *http.Request)
+}
+
+// oidcHandler 구조체는 OIDCHandler 인터페이스를 구현합니다.
+type oidcHandler struct {
+	oidcService service.OIDCService
+}
+
+// NewOIDCHandler 함수는 OIDCHandler 인터페이스의 구현체를 반환합니다.
+func NewOIDCHandler(oidcService service.OIDCService) OIDCHandler {
+	return &oidcHandler{
+		oidcService: oidcService,
+	}
+}
+
+/* Authorize 함수는 외부 계정 로그인을 시작하고 제공자의 인가 요청 주소를 반환하는 핸들러입니다. */
+func (h *oidcHandler) Authorize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	// 경로 변수에서 제공자 이름 추출 (예: /oidc/{provider}/authorize/)
+	provider := r.PathValue("provider")
+	if provider == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrOIDCProviderRequired.Error())
+		return
+	}
+
+	authURL, status, err := h.oidcService.StartSignin(r.Context(), provider)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.OIDCAuthorizeResponseDTO{
+		AuthorizationURL: authURL,
+	}
+	response.Success(w, status, "Authorization URL created successfully", res)
+}
+
+/* Callback 함수는 제공자가 전달한 인가 코드로 로그인하는 핸들러입니다. */
+func (h *oidcHandler) Callback(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	provider := r.PathValue("provider")
+	if provider == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrOIDCProviderRequired.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.OIDCCallbackDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	inp.IP = utils.GetClientIP(r)
+	inp.UserAgent = r.UserAgent()
+
+	signinResponse, status, err := h.oidcService.Signin(r.Context(), provider, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "User signed in successfully", signinResponse)
+}
+
+/* GetIdentities 함수는 사용자에게 연결된 외부 계정 목록을 조회하는 핸들러입니다. */
+func (h *oidcHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	identities, status, err := h.oidcService.ListIdentities(r.Context(), userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Identities retrieved successfully", identities)
+}
+
+/* StartLink 함수는 외부 계정 연결을 시작하고 제공자의 인가 요청 주소를 반환하는 핸들러입니다. */
+func (h *oidcHandler) StartLink(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	provider := r.PathValue("provider")
+	if provider == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrOIDCProviderRequired.Error())
+		return
+	}
+
+	authURL, status, err := h.oidcService.StartLink(r.Context(), userID, provider)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.OIDCAuthorizeResponseDTO{
+		AuthorizationURL: authURL,
+	}
+	response.Success(w, status, "Authorization URL created successfully", res)
+}
+
+/* LinkCallback 함수는 제공자가 전달한 인가 코드로 외부 계정을 연결하는 핸들러입니다. */
+func (h *oidcHandler) LinkCallback(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	provider := r.PathValue("provider")
+	if provider == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrOIDCProviderRequired.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.OIDCCallbackDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	identity, status, err := h.oidcService.Link(r.Context(), userID, provider, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.UserIdentityResponseDTO{
+		Identity: identity,
+	}
+	response.Success(w, status, "Identity linked successfully", res)
+}
+
+/* Unlink 함수는 외부 계정 연결을 해제하는 핸들러입니다. */
+func (h *oidcHandler) Unlink(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	provider := r.PathValue("provider")
+	if provider == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrOIDCProviderRequired.Error())
+		return
+	}
+
+	status, err := h.oidcService.Unlink(r.Context(), userID, provider)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Identity unlinked successfully", nil)
+}
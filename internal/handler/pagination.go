@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// parsePageParams 함수는 쿼리 문자열의 page, limit 값을 정수로 변환합니다. 생략된 값은 0을 반환하여 DTO의 기본값을 사용합니다.
+func parsePageParams(params url.Values) (int, int, error) {
+	page, limit := 0, 0
+
+	if v := params.Get("page"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 1 {
+			return 0, 0, apperror.ErrHttpInvalidPage
+		}
+		page = parsed
+	}
+	if v := params.Get("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 1 {
+			return 0, 0, apperror.ErrHttpInvalidLimit
+		}
+		limit = parsed
+	}
+
+	return page, limit, nil
+}
+
+// parseCursorPageParams 함수는 쿼리 문자열의 limit, cursor, include_total 값으로 커서 페이지 요청을 만듭니다.
+// 생략된 limit은 0으로 두어 DTO의 기본값을 사용하며, 커서 해석은 DTO의 Validate에서 수행합니다.
+func parseCursorPageParams(params url.Values) (dto.CursorPageDTO, error) {
+	page := dto.CursorPageDTO{
+		Cursor:       params.Get("cursor"),
+		IncludeTotal: params.Get("include_total") == "true",
+	}
+
+	if v := params.Get("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed < 1 {
+			return page, apperror.ErrHttpInvalidLimit
+		}
+		page.Limit = parsed
+	}
+
+	return page, nil
+}
@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/middleware"
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/internal/service"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+// PersonalAccessTokenHandler 인터페이스는 개인 액세스 토큰 관련 핸들러의 메서드를 정의합니다.
+type PersonalAccessTokenHandler interface {
+	Tokens(w http.ResponseWriter, r *http.Request)
+	RevokeToken(w http.ResponseWriter, r *http.Request)
+}
+
+// personalAccessTokenHandler 구조체는 PersonalAccessTokenHandler 인터페이스를 구현합니다.
+type personalAccessTokenHandler struct {
+	personalAccessTokenService service.PersonalAccessTokenService
+}
+
+// NewPersonalAccessTokenHandler 함수는 PersonalAccessTokenHandler 인터페이스의 구현체를 반환합니다.
+func NewPersonalAccessTokenHandler(personalAccessTokenService service.PersonalAccessTokenService) PersonalAccessTokenHandler {
+	return &personalAccessTokenHandler{
+		personalAccessTokenService: personalAccessTokenService,
+	}
+}
+
+/* Tokens 함수는 개인 액세스 토큰 목록 조회(GET)와 발급(POST)을 처리하는 핸들러입니다. */
+func (h *personalAccessTokenHandler) Tokens(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.listTokens(w, r)
+	case http.MethodPost:
+		h.createToken(w, r)
+	default:
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+	}
+}
+
+// listTokens 함수는 사용자의 개인 액세스 토큰 목록을 조회합니다.
+func (h *personalAccessTokenHandler) listTokens(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	tokens, status, err := h.personalAccessTokenService.ListTokens(r.Context(), userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.GetPersonalAccessTokensResponseDTO{
+		PersonalAccessTokens: tokens,
+	}
+	response.Success(w, status, "Personal access tokens retrieved successfully", res)
+}
+
+// createToken 함수는 새 개인 액세스 토큰을 발급합니다.
+func (h *personalAccessTokenHandler) createToken(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.CreatePersonalAccessTokenDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	inp.UserID = userID
+
+	rawToken, token, status, err := h.personalAccessTokenService.CreateToken(r.Context(), inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.CreatePersonalAccessTokenResponseDTO{
+		Token:               rawToken,
+		PersonalAccessToken: token,
+	}
+	response.Success(w, status, "Personal access token created successfully", res)
+}
+
+/* RevokeToken 함수는 개인 액세스 토큰을 폐기하는 핸들러입니다. */
+func (h *personalAccessTokenHandler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// 경로 변수에서 토큰 id 추출 (예: /tokens/{id}/)
+	id := r.PathValue("id")
+	if id == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrPersonalAccessTokenIDRequired.Error())
+		return
+	}
+
+	status, err := h.personalAccessTokenService.RevokeToken(r.Context(), userID, utils.InterfaceToInt64(id))
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Personal access token revoked successfully", nil)
+}
@@ -0,0 +1,157 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/middleware"
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/internal/service"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+// TagHandler는 태그 관리 HTTP 요청을 처리하는 인터페이스입니다.
+type TagHandler interface {
+	GetTagsByCreatorID(w http.ResponseWriter, r *http.Request)
+	UpdateTag(w http.ResponseWriter, r *http.Request)
+	MergeTag(w http.ResponseWriter, r *http.Request)
+	DeleteTag(w http.ResponseWriter, r *http.Request)
+}
+
+// tagHandler 구조체는 TagHandler 인터페이스를 구현합니다.
+type tagHandler struct {
+	tagService service.TagService
+}
+
+// NewTagHandler 함수는 TagHandler 인터페이스의 구현체를 반환합니다.
+func NewTagHandler(tagService service.TagService) TagHandler {
+	return &tagHandler{
+		tagService: tagService,
+	}
+}
+
+// GetTagsByCreatorID 함수는 사용자의 태그 목록과 태그별 일기 수를 조회하는 HTTP 핸들러입니다.
+func (h *tagHandler) GetTagsByCreatorID(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	res, status, err := h.tagService.GetTagsByCreatorID(r.Context(), userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Tags retrieved successfully", res)
+}
+
+// UpdateTag 함수는 태그 이름을 변경하는 HTTP 핸들러입니다.
+func (h *tagHandler) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	id := r.PathValue("id")
+	if id == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrTagIDIsRequired.Error())
+		return
+	}
+
+	var updateTagDTO dto.UpdateTagDTO
+	if err := json.NewDecoder(r.Body).Decode(&updateTagDTO); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+	updateTagDTO.ID = utils.InterfaceToInt64(id)
+	updateTagDTO.CreatorID = userID
+
+	tag, status, err := h.tagService.UpdateTagName(r.Context(), updateTagDTO)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.TagResponseDTO{Tag: tag}
+	response.Success(w, status, "Tag updated successfully", res)
+}
+
+// MergeTag 함수는 경로의 태그를 요청 본문의 target_id 태그로 병합하는 HTTP 핸들러입니다.
+func (h *tagHandler) MergeTag(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	id := r.PathValue("id")
+	if id == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrTagIDIsRequired.Error())
+		return
+	}
+
+	var mergeTagDTO dto.MergeTagDTO
+	if err := json.NewDecoder(r.Body).Decode(&mergeTagDTO); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+	mergeTagDTO.SourceID = utils.InterfaceToInt64(id)
+	mergeTagDTO.CreatorID = userID
+
+	tag, status, err := h.tagService.MergeTags(r.Context(), mergeTagDTO)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.TagResponseDTO{Tag: tag}
+	response.Success(w, status, "Tags merged successfully", res)
+}
+
+// DeleteTag 함수는 태그를 삭제하는 HTTP 핸들러입니다.
+func (h *tagHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	id := r.PathValue("id")
+	if id == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrTagIDIsRequired.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	status, err := h.tagService.DeleteTag(r.Context(), utils.InterfaceToInt64(id), userID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Tag deleted successfully", nil)
+}
@@ -9,14 +9,25 @@ import (
 	"github.com/jhphon0730/dairify/internal/response"
 	"github.com/jhphon0730/dairify/internal/service"
 	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
 )
 
 // UserHandler 인터페이스는 사용자 관련 핸들러의 메서드를 정의합니다.
 type UserHandler interface {
 	SignupUser(w http.ResponseWriter, r *http.Request)
 	SigninUser(w http.ResponseWriter, r *http.Request)
+	SigninMFA(w http.ResponseWriter, r *http.Request)
 	SignoutUser(w http.ResponseWriter, r *http.Request)
+	SignoutOtherSessions(w http.ResponseWriter, r *http.Request)
+	RefreshUser(w http.ResponseWriter, r *http.Request)
+	GetSessions(w http.ResponseWriter, r *http.Request)
+	RevokeSession(w http.ResponseWriter, r *http.Request)
 	ProfileUser(w http.ResponseWriter, r *http.Request)
+	ChangePassword(w http.ResponseWriter, r *http.Request)
+	RequestPasswordReset(w http.ResponseWriter, r *http.Request)
+	ConfirmPasswordReset(w http.ResponseWriter, r *http.Request)
+	VerifyEmail(w http.ResponseWriter, r *http.Request)
+	ResendVerificationEmail(w http.ResponseWriter, r *http.Request)
 }
 
 // userHandler 구조체는 UserHandler 인터페이스를 구현합니다.
@@ -72,22 +83,48 @@ func (h *userHandler) SigninUser(w http.ResponseWriter, r *http.Request) {
 		response.Error(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	inp.IP = utils.GetClientIP(r)
+	inp.UserAgent = r.UserAgent()
 
 	// Service 함수 호출
-	accessToken, refreshToken, user, status, err := h.userService.SigninUser(r.Context(), inp)
+	signinResponse, status, err := h.userService.SigninUser(r.Context(), inp)
 	if err != nil {
 		response.Error(w, status, err.Error())
 		return
 	}
 
-	signinResponse := dto.UserSigninResponseDTO{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		User:         user,
+	if signinResponse.MFARequired {
+		response.Success(w, status, "Two-factor authentication required", signinResponse)
+		return
 	}
 	response.Success(w, status, "User signed in successfully", signinResponse)
 }
 
+/* SigninMFA 함수는 2단계 인증 코드를 확인하고 로그인을 완료하는 핸들러입니다. */
+func (h *userHandler) SigninMFA(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.UserSigninMFADTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	inp.IP = utils.GetClientIP(r)
+	inp.UserAgent = r.UserAgent()
+
+	signinResponse, status, err := h.userService.SigninMFA(r.Context(), inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "User signed in successfully", signinResponse)
+}
+
 /* SignoutUser 함수는 사용자를 로그아웃하는 핸들러입니다. */
 func (h *userHandler) SignoutUser(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -101,7 +138,13 @@ func (h *userHandler) SignoutUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	status, err := h.userService.SignoutUser(r.Context(), userID)
+	sessionID, ok := middleware.GetSessionIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	status, err := h.userService.SignoutUser(r.Context(), userID, sessionID)
 	if err != nil {
 		response.Error(w, http.StatusInternalServerError, err.Error())
 		return
@@ -110,8 +153,36 @@ func (h *userHandler) SignoutUser(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, status, "User signed out successfully", nil)
 }
 
-/* ProfileUser 함수는 사용자의 프로필 정보를 조회하는 핸들러입니다. */
-func (h *userHandler) ProfileUser(w http.ResponseWriter, r *http.Request) {
+/* SignoutOtherSessions 함수는 현재 세션을 제외한 모든 세션을 로그아웃하는 핸들러입니다. */
+func (h *userHandler) SignoutOtherSessions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	sessionID, ok := middleware.GetSessionIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	status, err := h.userService.SignoutOtherSessions(r.Context(), userID, sessionID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Other sessions signed out successfully", nil)
+}
+
+/* GetSessions 함수는 사용자의 로그인 세션 목록을 조회하는 핸들러입니다. */
+func (h *userHandler) GetSessions(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
 		return
@@ -123,6 +194,103 @@ func (h *userHandler) ProfileUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	sessionID, ok := middleware.GetSessionIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	sessions, status, err := h.userService.GetSessions(r.Context(), userID, sessionID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.UserSessionsResponseDTO{
+		Sessions: sessions,
+	}
+	response.Success(w, status, "User sessions retrieved successfully", res)
+}
+
+/* RevokeSession 함수는 사용자의 특정 세션을 폐기하는 핸들러입니다. */
+func (h *userHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// 경로 변수에서 세션 id 추출 (예: /sessions/{id}/)
+	sessionID := r.PathValue("id")
+	if sessionID == "" {
+		response.Error(w, http.StatusBadRequest, apperror.ErrUserSessionIDRequired.Error())
+		return
+	}
+
+	status, err := h.userService.RevokeSession(r.Context(), userID, sessionID)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Session revoked successfully", nil)
+}
+
+/* RefreshUser 함수는 리프레시 토큰으로 새로운 토큰을 발급하는 핸들러입니다. */
+func (h *userHandler) RefreshUser(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.UserRefreshDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	inp.IP = utils.GetClientIP(r)
+	inp.UserAgent = r.UserAgent()
+
+	// Service 함수 호출
+	accessToken, refreshToken, status, err := h.userService.RefreshToken(r.Context(), inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.UserRefreshResponseDTO{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+	response.Success(w, status, "Token refreshed successfully", res)
+}
+
+/* ProfileUser 함수는 사용자의 프로필 정보를 조회(GET)하거나 수정(PUT, PATCH)하는 핸들러입니다. */
+func (h *userHandler) ProfileUser(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.getProfile(w, r)
+	case http.MethodPut, http.MethodPatch:
+		h.updateProfile(w, r)
+	default:
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+	}
+}
+
+// getProfile 함수는 사용자의 프로필 정보를 조회합니다.
+func (h *userHandler) getProfile(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
 	user, status, err := h.userService.Profile(r.Context(), userID)
 	if err != nil {
 		response.Error(w, status, err.Error())
@@ -134,3 +302,161 @@ func (h *userHandler) ProfileUser(w http.ResponseWriter, r *http.Request) {
 	}
 	response.Success(w, status, "User profile retrieved successfully", res)
 }
+
+// updateProfile 함수는 사용자의 닉네임, 이메일, 시간대를 수정합니다.
+func (h *userHandler) updateProfile(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.UserUpdateProfileDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user, status, err := h.userService.UpdateProfile(r.Context(), userID, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.UserProfileResponseDTO{
+		User: user,
+	}
+	response.Success(w, status, "User profile updated successfully", res)
+}
+
+/* ChangePassword 함수는 사용자의 비밀번호를 변경하는 핸들러입니다. */
+func (h *userHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	userID, ok := middleware.GetUserIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	sessionID, ok := middleware.GetSessionIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.UserChangePasswordDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	accessToken, refreshToken, status, err := h.userService.ChangePassword(r.Context(), userID, sessionID, inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	res := dto.UserChangePasswordResponseDTO{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}
+	response.Success(w, status, "Password changed successfully", res)
+}
+
+/* RequestPasswordReset 함수는 비밀번호 재설정 메일을 요청하는 핸들러입니다. */
+func (h *userHandler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.UserPasswordResetRequestDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	status, err := h.userService.RequestPasswordReset(r.Context(), inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "If the email is registered, a password reset link has been sent", nil)
+}
+
+/* ConfirmPasswordReset 함수는 재설정 토큰으로 새 비밀번호를 설정하는 핸들러입니다. */
+func (h *userHandler) ConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.UserPasswordResetConfirmDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	status, err := h.userService.ConfirmPasswordReset(r.Context(), inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Password reset successfully", nil)
+}
+
+/* VerifyEmail 함수는 이메일 인증 토큰으로 이메일 인증을 완료하는 핸들러입니다. */
+func (h *userHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.UserVerifyEmailDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	status, err := h.userService.VerifyEmail(r.Context(), inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "Email verified successfully", nil)
+}
+
+/* ResendVerificationEmail 함수는 이메일 인증 메일을 다시 발송하는 핸들러입니다. */
+func (h *userHandler) ResendVerificationEmail(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		response.Error(w, http.StatusMethodNotAllowed, apperror.ErrHttpMethodNotAllowed.Error())
+		return
+	}
+
+	// body로 Input 받기
+	var inp dto.UserResendVerificationDTO
+	if err := json.NewDecoder(r.Body).Decode(&inp); err != nil && err.Error() != "EOF" {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	status, err := h.userService.ResendVerificationEmail(r.Context(), inp)
+	if err != nil {
+		response.Error(w, status, err.Error())
+		return
+	}
+
+	response.Success(w, status, "If the email needs verification, a new link has been sent", nil)
+}
@@ -0,0 +1,31 @@
+package job
+
+import (
+	"context"
+
+	"github.com/jhphon0730/dairify/internal/service"
+)
+
+const ACCOUNT_DELETION_JOB_NAME = "account-deletion"
+
+// accountDeletionJob 구조체는 유예 기간이 끝난 계정을 영구 삭제하는 작업입니다.
+type accountDeletionJob struct {
+	accountService service.AccountService
+}
+
+// NewAccountDeletionJob 함수는 계정 영구 삭제 작업을 생성합니다.
+func NewAccountDeletionJob(accountService service.AccountService) Job {
+	return &accountDeletionJob{
+		accountService: accountService,
+	}
+}
+
+// Name 함수는 작업 이름을 반환합니다.
+func (j *accountDeletionJob) Name() string {
+	return ACCOUNT_DELETION_JOB_NAME
+}
+
+// Run 함수는 삭제 예정 시각이 지난 계정을 모두 삭제합니다.
+func (j *accountDeletionJob) Run(ctx context.Context) error {
+	return j.accountService.PurgeScheduledAccounts(ctx)
+}
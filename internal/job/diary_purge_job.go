@@ -0,0 +1,31 @@
+package job
+
+import (
+	"context"
+
+	"github.com/jhphon0730/dairify/internal/service"
+)
+
+const DIARY_PURGE_JOB_NAME = "diary-purge"
+
+// diaryPurgeJob 구조체는 휴지통 보관 기간이 지난 일기를 영구 삭제하는 작업입니다.
+type diaryPurgeJob struct {
+	diaryService service.DiaryService
+}
+
+// NewDiaryPurgeJob 함수는 휴지통 일기 영구 삭제 작업을 생성합니다.
+func NewDiaryPurgeJob(diaryService service.DiaryService) Job {
+	return &diaryPurgeJob{
+		diaryService: diaryService,
+	}
+}
+
+// Name 함수는 작업 이름을 반환합니다.
+func (j *diaryPurgeJob) Name() string {
+	return DIARY_PURGE_JOB_NAME
+}
+
+// Run 함수는 휴지통에 보관 기간 이상 머문 일기를 모두 영구 삭제합니다.
+func (j *diaryPurgeJob) Run(ctx context.Context) error {
+	return j.diaryService.PurgeExpiredDiaries(ctx)
+}
@@ -0,0 +1,88 @@
+package job
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Job 인터페이스는 주기적으로 실행되는 백그라운드 작업을 정의합니다.
+type Job interface {
+	Name() string
+	Run(ctx context.Context) error
+}
+
+// Scheduler 인터페이스는 백그라운드 작업의 등록, 시작, 종료를 정의합니다.
+type Scheduler interface {
+	Register(job Job, interval time.Duration)
+	Start()
+	Stop()
+}
+
+// scheduledJob 구조체는 작업과 실행 간격을 묶어 보관합니다.
+type scheduledJob struct {
+	job      Job
+	interval time.Duration
+}
+
+// scheduler 구조체는 Scheduler 인터페이스를 구현합니다.
+type scheduler struct {
+	jobs   []scheduledJob
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler 함수는 Scheduler 인터페이스의 구현체를 반환합니다.
+func NewScheduler() Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &scheduler{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Register 함수는 작업을 지정한 간격으로 실행하도록 등록합니다. Start 이전에 호출해야 합니다.
+func (s *scheduler) Register(job Job, interval time.Duration) {
+	s.jobs = append(s.jobs, scheduledJob{job: job, interval: interval})
+}
+
+// Start 함수는 등록된 작업을 각각의 고루틴에서 실행합니다. 각 작업은 시작 즉시 한 번 실행됩니다.
+func (s *scheduler) Start() {
+	for _, sj := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(sj)
+	}
+}
+
+// Stop 함수는 실행 중인 작업에 취소 신호를 보내고 모두 종료될 때까지 기다립니다.
+func (s *scheduler) Stop() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// loop 함수는 종료 신호를 받을 때까지 작업을 주기적으로 실행합니다.
+func (s *scheduler) loop(sj scheduledJob) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(sj.interval)
+	defer ticker.Stop()
+
+	for {
+		s.run(sj.job)
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// run 함수는 작업을 한 번 실행하고 실패 시 로그를 남깁니다.
+func (s *scheduler) run(job Job) {
+	if err := job.Run(s.ctx); err != nil {
+		log.Printf("Job %s failed: %v", job.Name(), err)
+	}
+}
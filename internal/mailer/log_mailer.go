@@ -0,0 +1,43 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jhphon0730/dairify/internal/config"
+)
+
+const (
+	MAIL_FILE_MODE = 0o600 // 메일 파일 권한 (토큰 등 민감 정보 포함)
+	MAIL_DIR_MODE  = 0o700 // 메일 디렉터리 권한
+)
+
+// logMailer 구조체는 개발 환경에서 메일을 실제로 보내지 않고 파일로 저장하는 Mailer 구현체입니다.
+type logMailer struct {
+	cfg config.Mail
+}
+
+// newLogMailer 함수는 logMailer 인스턴스를 생성합니다.
+func newLogMailer(cfg config.Mail) Mailer {
+	return &logMailer{cfg: cfg}
+}
+
+// Send 함수는 메일 원문을 .eml 파일로 저장하고 저장 경로를 로그로 출력합니다.
+func (m *logMailer) Send(ctx context.Context, msg *Message) error {
+	if err := os.MkdirAll(m.cfg.LogDir, MAIL_DIR_MODE); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("mail_%d.eml", time.Now().UnixNano())
+	fullPath := filepath.Join(m.cfg.LogDir, name)
+	if err := os.WriteFile(fullPath, buildMessage(m.cfg.From, msg), MAIL_FILE_MODE); err != nil {
+		return err
+	}
+
+	log.Printf("Mail to %s (%s) saved to %s", sanitizeHeader(msg.To), msg.Subject, fullPath)
+	return nil
+}
@@ -0,0 +1,143 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+const (
+	SMTP_DRIVER = "smtp" // SMTP 서버로 실제 발송
+	LOG_DRIVER  = "log"  // 개발용: 파일로 저장하고 로그 출력
+
+	SEND_TIMEOUT = 30 * time.Second // 비동기 발송 시 타임아웃
+
+	MIME_LINE_LENGTH = 76 // base64 인코딩 시 한 줄 최대 길이
+)
+
+// Attachment 구조체는 메일에 첨부할 파일을 나타냅니다.
+type Attachment struct {
+	FileName    string
+	ContentType string
+	Data        []byte
+}
+
+// Message 구조체는 발송할 메일 한 통을 나타냅니다.
+type Message struct {
+	To          string
+	Subject     string
+	Body        string // text/plain 본문
+	Attachments []Attachment
+}
+
+// Mailer 인터페이스는 메일 발송 방식을 추상화합니다.
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+var (
+	mailer_once     sync.Once
+	mailer_instance Mailer
+	mailer_err      error
+)
+
+// NewMailer 함수는 설정된 드라이버에 맞는 Mailer 구현체를 생성합니다.
+func NewMailer(cfg config.Mail) (Mailer, error) {
+	switch cfg.Driver {
+	case SMTP_DRIVER:
+		return newSMTPMailer(cfg), nil
+	case LOG_DRIVER, "":
+		return newLogMailer(cfg), nil
+	default:
+		return nil, apperror.ErrMailerUnknownDriver
+	}
+}
+
+// GetMailer 함수는 Mailer 인스턴스를 반환합니다. 싱글턴 패턴을 사용하여 인스턴스를 생성합니다.
+func GetMailer() (Mailer, error) {
+	mailer_once.Do(func() {
+		mailer_instance, mailer_err = NewMailer(config.GetConfig().Mail)
+	})
+	return mailer_instance, mailer_err
+}
+
+// SendAsync 함수는 요청 처리를 지연시키지 않도록 메일을 백그라운드에서 발송하고, 실패 시 로그만 남깁니다.
+func SendAsync(m Mailer, msg *Message) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), SEND_TIMEOUT)
+		defer cancel()
+
+		if err := m.Send(ctx, msg); err != nil {
+			log.Printf("Failed to send mail to %s: %v", sanitizeHeader(msg.To), err)
+		}
+	}()
+}
+
+// buildMessage 함수는 Message를 RFC 5322 형식의 메일 원문으로 변환합니다.
+// 첨부 파일이 있으면 multipart/mixed 형식으로 구성합니다.
+func buildMessage(from string, msg *Message) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", sanitizeHeader(msg.To))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	body := strings.ReplaceAll(msg.Body, "\n", "\r\n")
+	if len(msg.Attachments) == 0 {
+		buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+		buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+		buf.WriteString("\r\n")
+		buf.WriteString(body)
+		return buf.Bytes()
+	}
+
+	writer := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%s\r\n", writer.Boundary())
+	buf.WriteString("\r\n")
+
+	// 본문 파트
+	textHeader := textproto.MIMEHeader{}
+	textHeader.Set("Content-Type", "text/plain; charset=UTF-8")
+	textHeader.Set("Content-Transfer-Encoding", "8bit")
+	if part, err := writer.CreatePart(textHeader); err == nil {
+		part.Write([]byte(body))
+	}
+
+	// 첨부 파일 파트 (base64, 76자 줄바꿈)
+	for _, attachment := range msg.Attachments {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", attachment.ContentType)
+		header.Set("Content-Transfer-Encoding", "base64")
+		header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": sanitizeHeader(attachment.FileName)}))
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			continue
+		}
+		encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+		for len(encoded) > MIME_LINE_LENGTH {
+			part.Write([]byte(encoded[:MIME_LINE_LENGTH] + "\r\n"))
+			encoded = encoded[MIME_LINE_LENGTH:]
+		}
+		part.Write([]byte(encoded + "\r\n"))
+	}
+	writer.Close()
+
+	return buf.Bytes()
+}
+
+// sanitizeHeader 함수는 헤더 인젝션을 막기 위해 값에서 개행 문자를 제거합니다.
+func sanitizeHeader(value string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(value)
+}
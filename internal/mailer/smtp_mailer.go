@@ -0,0 +1,72 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/smtp"
+
+	"github.com/jhphon0730/dairify/internal/config"
+)
+
+// smtpMailer 구조체는 SMTP 서버를 통해 메일을 발송하는 Mailer 구현체입니다.
+type smtpMailer struct {
+	cfg config.Mail
+}
+
+// newSMTPMailer 함수는 smtpMailer 인스턴스를 생성합니다.
+func newSMTPMailer(cfg config.Mail) Mailer {
+	return &smtpMailer{cfg: cfg}
+}
+
+// Send 함수는 SMTP 서버에 접속하여 메일을 발송합니다. 서버가 지원하면 STARTTLS를 사용합니다.
+func (m *smtpMailer) Send(ctx context.Context, msg *Message) error {
+	addr := net.JoinHostPort(m.cfg.Host, m.cfg.Port)
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, m.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.cfg.Host}); err != nil {
+			return err
+		}
+	}
+
+	if m.cfg.Username != "" {
+		auth := smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(m.cfg.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(sanitizeHeader(msg.To)); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(buildMessage(m.cfg.From, msg)); err != nil {
+		writer.Close()
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/repository"
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+var (
+	userRepositoryOnce sync.Once
+	userRepository     repository.UserRepository
+)
+
+// getUserRepository 함수는 사용자 역할 확인에 사용할 저장소를 반환합니다.
+func getUserRepository() repository.UserRepository {
+	userRepositoryOnce.Do(func() {
+		userRepository = repository.NewUserRepository(database.GetDB())
+	})
+	return userRepository
+}
+
+// AdminOnlyMiddleware는 AuthMiddleware로 인증한 뒤 관리자 역할의 사용자만 통과시킵니다.
+// 역할은 토큰에 담지 않고 요청마다 데이터베이스에서 확인하므로, 역할이 회수되면 즉시 반영됩니다.
+// 개인 액세스 토큰으로는 관리자 API를 사용할 수 없습니다.
+func AdminOnlyMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return AuthMiddleware(SessionScopeMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := GetUserIDFromContext(r.Context())
+		if !ok {
+			response.Error(w, http.StatusUnauthorized, apperror.ErrAuthUnauthorized.Error())
+			return
+		}
+
+		user, err := getUserRepository().FindUserByUserID(r.Context(), userID)
+		if err != nil {
+			if errors.Is(err, apperror.ErrUserNotFound) {
+				response.Error(w, http.StatusUnauthorized, apperror.ErrAuthInvalidToken.Error())
+				return
+			}
+			response.Error(w, http.StatusInternalServerError, apperror.ErrInternalServerError.Error())
+			return
+		}
+
+		if user.IsDisabled() {
+			response.Error(w, http.StatusForbidden, apperror.ErrAuthAccountDisabled.Error())
+			return
+		}
+		if !user.IsAdmin() {
+			response.Error(w, http.StatusForbidden, apperror.ErrAuthAdminRequired.Error())
+			return
+		}
+
+		next(w, r)
+	}))
+}
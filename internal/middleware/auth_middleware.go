@@ -2,24 +2,55 @@ package middleware
 
 import (
 	"context"
+	"errors"
+	"log"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/jhphon0730/dairify/internal/auth"
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/model"
 	"github.com/jhphon0730/dairify/internal/redis"
+	"github.com/jhphon0730/dairify/internal/repository"
 	"github.com/jhphon0730/dairify/internal/response"
 	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
 )
 
 // 컨텍스트 키 상수 정의
 const (
-	USER_ID_CTX_KEY userIDContextKey = iota // 사용자 ID 컨텍스트 키
+	USER_ID_CTX_KEY    contextKey = iota // 사용자 ID 컨텍스트 키
+	SESSION_ID_CTX_KEY                   // 세션 ID 컨텍스트 키
+	SCOPES_CTX_KEY                       // 접근 권한(scope) 목록 컨텍스트 키
+	PAT_ID_CTX_KEY                       // 개인 액세스 토큰 ID 컨텍스트 키 (토큰으로 인증한 경우에만 설정)
+)
+
+// 접근 권한(scope) 상수 정의
+const (
+	SCOPE_READ  = "read"  // 조회 권한
+	SCOPE_WRITE = "write" // 생성, 수정, 삭제 권한
+
+	SCOPE_SESSION = "session" // 로그인 세션 전용 권한 (계정 보안 설정, 토큰 관리 등)
 )
 
 // 컨텍스트 키 타입 정의
-type userIDContextKey int
+type contextKey int
+
+var (
+	patRepositoryOnce sync.Once
+	patRepository     repository.PersonalAccessTokenRepository
+)
 
-// AuthMiddleware는 JWT 토큰을 검증하고 사용자 ID를 컨텍스트에 추가합니다.
+// getPersonalAccessTokenRepository 함수는 개인 액세스 토큰 검증에 사용할 저장소를 반환합니다.
+func getPersonalAccessTokenRepository() repository.PersonalAccessTokenRepository {
+	patRepositoryOnce.Do(func() {
+		patRepository = repository.NewPersonalAccessTokenRepository(database.GetDB())
+	})
+	return patRepository
+}
+
+// AuthMiddleware는 JWT 토큰 또는 개인 액세스 토큰을 검증하고 사용자 ID를 컨텍스트에 추가합니다.
 func AuthMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Authorization 헤더에서 토큰 추출
@@ -35,48 +66,139 @@ func AuthMiddleware(next http.HandlerFunc) http.HandlerFunc {
 			response.Error(w, http.StatusUnauthorized, apperror.ErrAuthRequiredToken.Error())
 			return
 		}
+
+		// 개인 액세스 토큰은 JWT와 별도로 검증
+		if auth.IsPersonalAccessToken(token) {
+			authenticatePersonalAccessToken(w, r, token, next)
+			return
+		}
+
 		claims, err := auth.ValidateAndParseJWT(token)
 		if err != nil {
 			response.Error(w, http.StatusUnauthorized, apperror.ErrAuthInvalidToken.Error())
 			return
 		}
 
+		// 리프레시 토큰으로는 API를 호출할 수 없음
+		if claims.TokenType != auth.ACCESS_TOKEN_TYPE {
+			response.Error(w, http.StatusUnauthorized, apperror.ErrAuthInvalidToken.Error())
+			return
+		}
+
 		// 컨텍스트에 사용자 ID 추가
 		userID := claims.UserID
+		sessionID := claims.ID
+		if sessionID == "" {
+			response.Error(w, http.StatusUnauthorized, apperror.ErrAuthInvalidToken.Error())
+			return
+		}
 
-		// redis 클라이언트에서 사용자 토큰 검증
+		// redis 클라이언트에서 사용자 세션 검증
 		userRedisClient, err := redis.GetUserRedis(r.Context())
 		if err != nil {
 			response.Error(w, http.StatusInternalServerError, apperror.ErrInternalServerError.Error())
 			return
 		}
 
-		// 사용자 토큰을 redis에서 가져옴
-		storedToken, err := userRedisClient.GetUserToken(r.Context(), userID)
+		// 세션이 폐기되었거나 만료된 경우
+		session, err := userRedisClient.GetSession(r.Context(), userID, sessionID)
 		if err != nil {
-			// 토큰을 가져오는 중 에러 발생 시
 			response.Error(w, http.StatusUnauthorized, apperror.ErrAuthInvalidToken.Error())
 			return
 		}
-		if storedToken == "" {
-			// 저장된 토큰이 없는 경우
-			response.Error(w, http.StatusUnauthorized, apperror.ErrAuthInvalidToken.Error())
+
+		// 비활성화된 계정은 세션을 폐기하지만, 폐기 직전에 만들어진 세션이 남아 있을 수 있으므로 한 번 더 확인
+		disabled, err := userRedisClient.IsUserDisabled(r.Context(), userID)
+		if err != nil {
+			response.Error(w, http.StatusInternalServerError, apperror.ErrInternalServerError.Error())
+			return
+		}
+		if disabled {
+			response.Error(w, http.StatusForbidden, apperror.ErrAuthAccountDisabled.Error())
 			return
 		}
-		if storedToken != token {
-			// 저장된 토큰과 요청 토큰이 일치하지 않는 경우
+
+		// 읽기 전용 세션은 조회 권한만 부여
+		scopes := []string{SCOPE_READ, SCOPE_WRITE, SCOPE_SESSION}
+		if session.ReadOnly {
+			scopes = []string{SCOPE_READ, SCOPE_SESSION}
+		}
+
+		// 세션의 마지막 사용 시각 갱신 (조회 직후 세션이 폐기된 경우에는 거부하고, 그 외 실패는 요청을 계속 처리)
+		if err := userRedisClient.TouchSession(r.Context(), userID, sessionID); errors.Is(err, apperror.ErrUserRedisSessionNotFound) {
 			response.Error(w, http.StatusUnauthorized, apperror.ErrAuthInvalidToken.Error())
 			return
 		}
 
-		// 사용자 ID를 컨텍스트에 추가
+		// 사용자 ID, 세션 ID, 접근 권한을 컨텍스트에 추가
 		ctx := context.WithValue(r.Context(), USER_ID_CTX_KEY, int64(userID))
+		ctx = context.WithValue(ctx, SESSION_ID_CTX_KEY, sessionID)
+		ctx = context.WithValue(ctx, SCOPES_CTX_KEY, scopes)
 		next(w, r.WithContext(ctx))
 	}
 }
 
+// authenticatePersonalAccessToken은 개인 액세스 토큰을 검증하고 토큰 권한에 맞는 접근 권한을 컨텍스트에 추가합니다.
+// 토큰 인증에는 세션이 없으므로 SCOPE_SESSION이 부여되지 않습니다.
+func authenticatePersonalAccessToken(w http.ResponseWriter, r *http.Request, token string, next http.HandlerFunc) {
+	tokenRepository := getPersonalAccessTokenRepository()
+
+	pat, err := tokenRepository.FindActiveTokenByHash(r.Context(), utils.HashToken(token))
+	if err != nil {
+		response.Error(w, http.StatusUnauthorized, apperror.ErrAuthInvalidToken.Error())
+		return
+	}
+
+	scopes := []string{SCOPE_READ}
+	if pat.Scope == model.PAT_SCOPE_READ_WRITE {
+		scopes = []string{SCOPE_READ, SCOPE_WRITE}
+	}
+
+	// 마지막 사용 시각 갱신 (실패해도 요청은 계속 처리)
+	if err := tokenRepository.TouchToken(r.Context(), pat.ID); err != nil {
+		log.Printf("Failed to update personal access token last used time: %v", err)
+	}
+
+	ctx := context.WithValue(r.Context(), USER_ID_CTX_KEY, pat.UserID)
+	ctx = context.WithValue(ctx, PAT_ID_CTX_KEY, pat.ID)
+	ctx = context.WithValue(ctx, SCOPES_CTX_KEY, scopes)
+	next(w, r.WithContext(ctx))
+}
+
 // GetUserIDFromContext는 컨텍스트에서 사용자 ID를 반환합니다.
 func GetUserIDFromContext(ctx context.Context) (int64, bool) {
 	userID, ok := ctx.Value(USER_ID_CTX_KEY).(int64) // 사용자 정의 타입 키 사용
 	return userID, ok
 }
+
+// GetSessionIDFromContext는 컨텍스트에서 세션 ID를 반환합니다.
+func GetSessionIDFromContext(ctx context.Context) (string, bool) {
+	sessionID, ok := ctx.Value(SESSION_ID_CTX_KEY).(string)
+	return sessionID, ok
+}
+
+// GetPersonalAccessTokenIDFromContext는 컨텍스트에서 개인 액세스 토큰 ID를 반환합니다.
+func GetPersonalAccessTokenIDFromContext(ctx context.Context) (int64, bool) {
+	tokenID, ok := ctx.Value(PAT_ID_CTX_KEY).(int64)
+	return tokenID, ok
+}
+
+// GetScopesFromContext는 컨텍스트에서 접근 권한 목록을 반환합니다.
+func GetScopesFromContext(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(SCOPES_CTX_KEY).([]string)
+	return scopes, ok
+}
+
+// HasScope는 컨텍스트에 지정한 접근 권한이 있는지 확인합니다.
+func HasScope(ctx context.Context, scope string) bool {
+	scopes, ok := GetScopesFromContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -8,3 +8,23 @@ import (
 func ChainLoggingWithAuthMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return LoggingMiddleware(AuthMiddleware(next))
 }
+
+// ChainLoggingWithAuthWriteMiddleware 함수는 로깅, 사용자 인증, 쓰기 권한 확인 미들웨어를 한 번에 적용합니다.
+func ChainLoggingWithAuthWriteMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return LoggingMiddleware(AuthMiddleware(WriteScopeMiddleware(next)))
+}
+
+// ChainLoggingWithSessionMiddleware 함수는 로깅, 사용자 인증, 로그인 세션 확인 미들웨어를 한 번에 적용합니다.
+func ChainLoggingWithSessionMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return LoggingMiddleware(AuthMiddleware(SessionScopeMiddleware(next)))
+}
+
+// ChainLoggingWithSessionWriteMiddleware 함수는 로깅, 사용자 인증, 로그인 세션 확인, 쓰기 권한 확인 미들웨어를 한 번에 적용합니다.
+func ChainLoggingWithSessionWriteMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return LoggingMiddleware(AuthMiddleware(SessionScopeMiddleware(WriteScopeMiddleware(next))))
+}
+
+// ChainLoggingWithAdminMiddleware 함수는 로깅, 관리자 인증, 쓰기 권한 확인 미들웨어를 한 번에 적용합니다.
+func ChainLoggingWithAdminMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return LoggingMiddleware(AdminOnlyMiddleware(WriteScopeMiddleware(next)))
+}
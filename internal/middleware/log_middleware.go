@@ -3,6 +3,9 @@ package middleware
 import (
 	"log"
 	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/audit"
+	"github.com/jhphon0730/dairify/pkg/utils"
 )
 
 // loggingResponseWriter는 http.ResponseWriter를 래핑하여 상태 코드를 기록합니다.
@@ -23,6 +26,9 @@ func LoggingMiddleware(next http.HandlerFunc) http.HandlerFunc {
 		// 요청 정보 로깅
 		log.Printf("Received request: %s %s from %s", r.Method, r.URL.Path, r.RemoteAddr)
 
+		// 서비스 계층에서 감사 로그를 남길 수 있도록 클라이언트 정보를 컨텍스트에 추가
+		r = r.WithContext(audit.WithClientInfo(r.Context(), utils.GetClientIP(r), r.UserAgent()))
+
 		// ResponseWriter 래핑
 		lrw := &loggingResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
 
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/response"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// WriteScopeMiddleware는 조회(GET, HEAD)가 아닌 요청에 쓰기 권한이 있는지 확인합니다.
+// AuthMiddleware 뒤에 연결해야 합니다.
+func WriteScopeMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			next(w, r)
+			return
+		}
+
+		if !HasScope(r.Context(), SCOPE_WRITE) {
+			response.Error(w, http.StatusForbidden, apperror.ErrAuthReadOnlyAccess.Error())
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// SessionScopeMiddleware는 로그인 세션으로 인증한 요청만 통과시킵니다.
+// 개인 액세스 토큰으로는 계정 보안 설정이나 토큰 관리를 할 수 없도록 합니다. AuthMiddleware 뒤에 연결해야 합니다.
+func SessionScopeMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !HasScope(r.Context(), SCOPE_SESSION) {
+			response.Error(w, http.StatusForbidden, apperror.ErrAuthSessionRequired.Error())
+			return
+		}
+
+		next(w, r)
+	}
+}
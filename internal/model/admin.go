@@ -0,0 +1,22 @@
+package model
+
+// 관리자 사용자 목록의 상태 필터
+const (
+	ADMIN_USER_STATUS_ALL      = "all"      // 전체 사용자
+	ADMIN_USER_STATUS_ACTIVE   = "active"   // 활성 사용자
+	ADMIN_USER_STATUS_DISABLED = "disabled" // 비활성화된 사용자
+)
+
+// UserUsage는 사용자가 사용 중인 일기와 저장 공간 현황을 나타냅니다.
+type UserUsage struct {
+	DiaryCount        int64 `json:"diary_count"`         // 삭제되지 않은 일기 수
+	DeletedDiaryCount int64 `json:"deleted_diary_count"` // 삭제된 일기 수
+	ImageCount        int64 `json:"image_count"`         // 업로드한 이미지 수
+	StorageBytes      int64 `json:"storage_bytes"`       // 업로드한 이미지의 전체 크기 ( byte )
+}
+
+// AdminUserSummary는 관리자 API에서 조회하는 사용자 정보와 사용 현황입니다.
+type AdminUserSummary struct {
+	User  *User     `json:"user"`
+	Usage UserUsage `json:"usage"`
+}
@@ -0,0 +1,58 @@
+package model
+
+import "time"
+
+// 감사 로그 이벤트 종류
+const (
+	AUDIT_EVENT_SIGNIN_SUCCESS        = "signin.success"        // 로그인 성공 ( 토큰 발급 )
+	AUDIT_EVENT_SIGNIN_FAILURE        = "signin.failure"        // 로그인 실패
+	AUDIT_EVENT_SIGNOUT               = "signout"               // 현재 세션 로그아웃
+	AUDIT_EVENT_SIGNOUT_OTHERS        = "signout.others"        // 다른 모든 세션 로그아웃
+	AUDIT_EVENT_TOKEN_REFRESH         = "token.refresh"         // 토큰 재발급
+	AUDIT_EVENT_TOKEN_REFRESH_REUSED  = "token.refresh_reused"  // 이미 사용된 리프레시 토큰 제출 ( 세션 폐기 )
+	AUDIT_EVENT_PASSWORD_CHANGE       = "password.change"       // 비밀번호 변경
+	AUDIT_EVENT_PASSWORD_RESET        = "password.reset"        // 비밀번호 재설정
+	AUDIT_EVENT_EMAIL_CHANGE          = "email.change"          // 이메일 변경
+	AUDIT_EVENT_SESSION_REVOKE        = "session.revoke"        // 특정 세션 폐기
+	AUDIT_EVENT_ADMIN_USER_DISABLE    = "admin.user_disable"    // 관리자가 계정 비활성화
+	AUDIT_EVENT_ADMIN_USER_ENABLE     = "admin.user_enable"     // 관리자가 계정 활성화
+	AUDIT_EVENT_ADMIN_SESSIONS_REVOKE = "admin.sessions_revoke" // 관리자가 모든 세션 강제 폐기
+)
+
+// 감사 로그 메타데이터 키
+const (
+	AUDIT_META_METHOD     = "method"     // 로그인 방식 ( password, oidc, mfa )
+	AUDIT_META_PROVIDER   = "provider"   // 외부 로그인 제공자 이름
+	AUDIT_META_REASON     = "reason"     // 실패 사유
+	AUDIT_META_USERNAME   = "username"   // 로그인 시도에 사용한 아이디
+	AUDIT_META_SESSION_ID = "session_id" // 대상 세션 ID
+	AUDIT_META_COUNT      = "count"      // 함께 처리된 대상 수
+)
+
+// 로그인 방식
+const (
+	AUDIT_SIGNIN_METHOD_PASSWORD = "password"
+	AUDIT_SIGNIN_METHOD_OIDC     = "oidc"
+	AUDIT_SIGNIN_METHOD_MFA      = "mfa"
+)
+
+// 로그인 실패 사유
+const (
+	AUDIT_REASON_INVALID_CREDENTIALS = "invalid_credentials"
+	AUDIT_REASON_LOCKED              = "locked"
+	AUDIT_REASON_ACCOUNT_DISABLED    = "account_disabled"
+	AUDIT_REASON_EMAIL_NOT_VERIFIED  = "email_not_verified"
+	AUDIT_REASON_INVALID_MFA_CODE    = "invalid_mfa_code"
+)
+
+// AuditEvent는 보안 감사 로그 한 건을 나타냅니다. 기록된 이벤트는 수정되지 않습니다.
+type AuditEvent struct {
+	ID        int64             `json:"id"`
+	UserID    *int64            `json:"user_id,omitempty"`  // 대상 사용자 ID ( 존재하지 않는 아이디로 로그인에 실패한 경우 nil )
+	ActorID   *int64            `json:"actor_id,omitempty"` // 작업을 수행한 관리자 ID ( 본인이 수행한 경우 nil )
+	Type      string            `json:"type"`
+	IP        string            `json:"ip"`
+	UserAgent string            `json:"user_agent"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
@@ -1,22 +1,34 @@
 package model
 
-import "time"
+import (
+	"strconv"
+	"time"
+)
 
 // Diary는 일기(다이어리) 모델을 나타냅니다.
 type Diary struct {
-	ID         int64   `json:"id"`
-	CreatorID  int64   `json:"creator_id"`
-	CategoryID *int64  `json:"category_id,omitempty"`
-	Title      string  `json:"title"`
-	Content    string  `json:"content"`
-	CreatedAt  string  `json:"created_at"`
-	UpdatedAt  string  `json:"updated_at"`
-	IsDeleted  bool    `json:"is_deleted"`
-	DeletedAt  *string `json:"deleted_at,omitempty"`
+	ID            int64   `json:"id"`
+	CreatorID     int64   `json:"creator_id"`
+	CategoryID    *int64  `json:"category_id,omitempty"`
+	Title         string  `json:"title"`
+	Content       string  `json:"content"`
+	Mood          *string `json:"mood,omitempty"`           // 감정 태그 코드 ( moods.code )
+	MoodIntensity *int    `json:"mood_intensity,omitempty"` // 감정 강도 ( 1~5 )
+	Version       int     `json:"version"`                  // 수정할 때마다 1씩 증가하는 버전 ( ETag )
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+	IsDeleted     bool    `json:"is_deleted"`
+	DeletedAt     *string `json:"deleted_at,omitempty"`
 
+	Tags   []string      `json:"tags"`             // 일기에 지정된 태그 이름 ( 이름순 )
 	Images []*DiaryImage `json:"images,omitempty"` // 일기와 연관된 이미지들 ( 있을 경우 )
 }
 
+// ETag 함수는 일기 버전으로 만든 강한 ETag 값을 반환합니다.
+func (d *Diary) ETag() string {
+	return `"` + strconv.Itoa(d.Version) + `"`
+}
+
 // DiaryImage는 일기 이미지 모델을 나타냅니다.
 type DiaryImage struct {
 	ID          int64     `json:"id"`
@@ -0,0 +1,9 @@
+package model
+
+// DiaryCalendarDay는 월간 캘린더의 하루에 작성된 일기 요약을 나타냅니다.
+type DiaryCalendarDay struct {
+	Date       string  `json:"date"`        // 사용자 시간대 기준 날짜 ( YYYY-MM-DD )
+	Count      int64   `json:"count"`       // 작성한 일기 수
+	FirstTitle *string `json:"first_title"` // 가장 먼저 작성한 일기의 제목 ( 일기가 없으면 nil )
+	HasImages  bool    `json:"has_images"`  // 이미지가 있는 일기 포함 여부
+}
@@ -0,0 +1,13 @@
+package model
+
+// DiaryRevision은 일기 제목과 내용의 수정 기록 하나를 나타냅니다.
+type DiaryRevision struct {
+	ID           int64  `json:"id"`
+	DiaryID      int64  `json:"diary_id"`
+	Revision     int    `json:"revision"` // 일기별로 1부터 증가하는 수정 기록 번호
+	Title        string `json:"title"`
+	Content      string `json:"content,omitempty"`       // 목록 조회에서는 생략
+	RestoredFrom *int   `json:"restored_from,omitempty"` // 이전 수정 기록을 복원하여 만들어진 경우 복원한 수정 기록 번호
+	IsCurrent    bool   `json:"is_current"`              // 현재 일기 내용과 같은 최신 수정 기록 여부
+	CreatedAt    string `json:"created_at"`
+}
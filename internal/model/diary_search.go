@@ -0,0 +1,18 @@
+package model
+
+// DiarySearchResult는 일기 검색 결과 한 건을 나타냅니다.
+// 목록 응답이 커지지 않도록 본문 대신 검색어가 강조된 제목과 본문 스니펫을 포함합니다.
+type DiarySearchResult struct {
+	ID             int64   `json:"id"`
+	CategoryID     *int64  `json:"category_id,omitempty"`
+	Title          string  `json:"title"`
+	TitleHighlight string  `json:"title_highlight"` // 검색어가 <mark>로 강조된 제목 ( HTML 이스케이프됨 )
+	Snippet        string  `json:"snippet"`         // 검색어 주변 본문 일부 ( HTML 이스케이프됨 )
+	Score          float64 `json:"score"`           // 검색 관련도 점수 ( 높을수록 관련도 높음 )
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+	Mood           *string `json:"mood,omitempty"`
+	MoodIntensity  *int    `json:"mood_intensity,omitempty"`
+
+	Content string `json:"-"` // 스니펫 생성을 위한 본문 원문
+}
@@ -0,0 +1,24 @@
+package model
+
+// Mood는 일기에 지정할 수 있는 감정 태그를 나타냅니다. 감정 목록은 moods 테이블에서 관리합니다.
+type Mood struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+}
+
+// MoodCount는 한 기간 동안 특정 감정으로 작성한 일기 수와 평균 강도를 나타냅니다.
+type MoodCount struct {
+	Mood             string   `json:"mood"`
+	Count            int64    `json:"count"`
+	AverageIntensity *float64 `json:"average_intensity"` // 강도를 지정한 일기가 없으면 nil
+
+	IntensitySum   int64 `json:"-"` // 강도를 지정한 일기의 강도 합계
+	IntensityCount int64 `json:"-"` // 강도를 지정한 일기 수
+}
+
+// MoodPeriod는 기간(일, 주, 월) 하나의 감정 분포를 나타냅니다.
+type MoodPeriod struct {
+	Period string       `json:"period"` // 사용자 시간대 기준 기간 시작 날짜 ( YYYY-MM-DD )
+	Total  int64        `json:"total"`  // 감정을 지정한 일기 수
+	Moods  []*MoodCount `json:"moods"`  // 일기 수가 많은 순
+}
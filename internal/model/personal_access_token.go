@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// 개인 액세스 토큰 권한 범위
+const (
+	PAT_SCOPE_READ       = "read"       // 조회만 허용
+	PAT_SCOPE_READ_WRITE = "read_write" // 조회, 생성, 수정, 삭제 허용
+)
+
+// PersonalAccessToken은 스크립트 및 외부 연동에 사용하는 개인 액세스 토큰을 나타냅니다.
+// 토큰 원문은 저장하지 않으며, 목록에서 구분할 수 있도록 앞부분(TokenPrefix)만 보관합니다.
+type PersonalAccessToken struct {
+	ID          int64      `json:"id"`
+	UserID      int64      `json:"-"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	TokenHash   string     `json:"-"`
+	Scope       string     `json:"scope"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// Session은 로그인한 기기별 세션 정보를 나타내는 구조체입니다.
+type Session struct {
+	ID         string    `json:"id"`
+	UserID     int64     `json:"user_id"`
+	DeviceName string    `json:"device_name"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ReadOnly   bool      `json:"read_only"` // 이메일 미인증 등으로 읽기만 허용된 세션
+	Current    bool      `json:"current"`   // 요청한 세션인지 여부
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// 로그인 잠금 범위
+const (
+	SIGNIN_LOCKOUT_SCOPE_USERNAME = "username"
+	SIGNIN_LOCKOUT_SCOPE_IP       = "ip"
+)
+
+// SigninLockout은 로그인 실패 누적으로 발생한 잠금 기록을 나타냅니다.
+type SigninLockout struct {
+	ID           int64     `json:"id"`
+	Scope        string    `json:"scope"`      // username 또는 ip
+	Identifier   string    `json:"identifier"` // 잠긴 아이디 또는 IP
+	UserID       *int64    `json:"user_id,omitempty"`
+	IP           string    `json:"ip"` // 잠금을 발생시킨 요청의 IP
+	FailureCount int64     `json:"failure_count"`
+	LockedUntil  time.Time `json:"locked_until"`
+	CreatedAt    time.Time `json:"created_at"`
+}
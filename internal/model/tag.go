@@ -0,0 +1,10 @@
+package model
+
+// Tag는 일기에 여러 개 지정할 수 있는 사용자별 태그를 나타냅니다.
+type Tag struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	CreatorID  int64  `json:"creator_id"`
+	DiaryCount int64  `json:"diary_count"` // 태그가 지정된 일기 수 ( 삭제된 일기 제외 )
+	CreatedAt  string `json:"created_at"`
+}
@@ -2,12 +2,50 @@ package model
 
 import "time"
 
+// 사용자 역할 상수 정의
+const (
+	USER_ROLE_USER  = "user"  // 일반 사용자
+	USER_ROLE_ADMIN = "admin" // 관리자 ( 사용자 관리 API 사용 가능 )
+)
+
 // User는 사용자 정보를 나타내는 구조체입니다.
 type User struct {
 	ID        int64     `json:"id"`
 	Username  string    `json:"username"`
 	Nickname  string    `json:"nickname"`
-	Password  string    `json:"password"`
+	Password  string    `json:"-"` // 비밀번호 해시는 응답에 포함하지 않음
 	Email     string    `json:"email"`
+	Role      string    `json:"role"`
 	CreatedAt time.Time `json:"created_at"`
+
+	EmailVerifiedAt     *time.Time `json:"email_verified_at,omitempty"`     // 이메일 인증 완료 시각 ( 미인증 시 nil )
+	DeletionScheduledAt *time.Time `json:"deletion_scheduled_at,omitempty"` // 계정 삭제 예정 시각 ( 예정 없으면 nil )
+
+	TOTPSecret    *string    `json:"-"`                         // TOTP 비밀 키는 응답에 포함하지 않음
+	TOTPEnabledAt *time.Time `json:"totp_enabled_at,omitempty"` // 2단계 인증 활성화 시각 ( 비활성 시 nil )
+
+	DisabledAt *time.Time `json:"disabled_at,omitempty"` // 관리자가 계정을 비활성화한 시각 ( 활성 상태면 nil )
+
+	Timezone           string `json:"timezone"`             // 날짜 계산에 사용할 IANA 시간대 ( 빈 값이면 서버 기본 시간대 )
+	DiaryRevisionLimit int    `json:"diary_revision_limit"` // 일기별로 보관할 최대 수정 기록 수
+}
+
+// IsTOTPEnabled 함수는 사용자가 TOTP 2단계 인증을 활성화했는지 확인합니다.
+func (u *User) IsTOTPEnabled() bool {
+	return u.TOTPEnabledAt != nil && u.TOTPSecret != nil
+}
+
+// HasPassword 함수는 사용자가 비밀번호를 설정했는지 확인합니다. 외부 로그인으로 가입한 사용자는 비밀번호가 없습니다.
+func (u *User) HasPassword() bool {
+	return u.Password != ""
+}
+
+// IsAdmin 함수는 사용자가 관리자 역할인지 확인합니다.
+func (u *User) IsAdmin() bool {
+	return u.Role == USER_ROLE_ADMIN
+}
+
+// IsDisabled 함수는 관리자가 사용자 계정을 비활성화했는지 확인합니다.
+func (u *User) IsDisabled() bool {
+	return u.DisabledAt != nil
 }
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// 외부 로그인 요청 목적
+const (
+	OIDC_PURPOSE_SIGNIN = "signin" // 외부 계정으로 로그인 (처음이면 가입)
+	OIDC_PURPOSE_LINK   = "link"   // 로그인한 사용자에게 외부 계정 연결
+)
+
+// UserIdentity는 사용자와 연결된 외부 로그인 제공자의 계정을 나타냅니다.
+// 제공자 안에서 고유한 subject(sub 클레임)로 사용자를 식별합니다.
+type UserIdentity struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"-"`
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"-"`
+	Email     string    `json:"email,omitempty"` // 연결 당시 제공자가 알려준 이메일 ( 표시용 )
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// OIDCLoginState는 외부 로그인 시작부터 콜백까지 서버에 보관하는 요청 정보입니다.
+type OIDCLoginState struct {
+	Provider     string
+	Purpose      string
+	UserID       int64 // 외부 계정 연결 요청인 경우 요청한 사용자 ID
+	Nonce        string
+	CodeVerifier string // PKCE code_verifier
+}
@@ -0,0 +1,232 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/jhphon0730/dairify/pkg/apperror"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	ID_TOKEN_LEEWAY    = time.Minute    // 제공자와 서버의 시계 오차 허용 범위
+	JWKS_REFRESH_DELAY = time.Minute    // 모르는 kid로 서명 키를 다시 가져오는 최소 간격
+	JWKS_CACHE_EXPIRY  = 24 * time.Hour // 서명 키 목록 보관 기간
+)
+
+// ID 토큰 서명에 허용하는 알고리즘 (비대칭 알고리즘만 허용)
+var idTokenSigningMethods = []string{"RS256", "ES256", "EdDSA"}
+
+// flexibleBool 타입은 true 또는 "true"로 전달되는 불리언 클레임을 함께 처리합니다.
+// 일부 제공자는 email_verified를 문자열로 전달합니다.
+type flexibleBool bool
+
+// UnmarshalJSON 함수는 불리언 또는 문자열 값을 flexibleBool로 변환합니다.
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case bool:
+		*b = flexibleBool(v)
+	case string:
+		*b = flexibleBool(v == "true")
+	default:
+		*b = false
+	}
+	return nil
+}
+
+// IDTokenClaims 구조체는 ID 토큰에서 사용하는 클레임입니다.
+type IDTokenClaims struct {
+	Nonce             string       `json:"nonce"`
+	AuthorizedParty   string       `json:"azp,omitempty"`
+	Email             string       `json:"email,omitempty"`
+	EmailVerified     flexibleBool `json:"email_verified,omitempty"`
+	Name              string       `json:"name,omitempty"`
+	PreferredUsername string       `json:"preferred_username,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// IsEmailVerified 함수는 제공자가 이메일 주소를 확인했는지 반환합니다.
+func (c *IDTokenClaims) IsEmailVerified() bool {
+	return bool(c.EmailVerified)
+}
+
+// VerifyIDToken 함수는 ID 토큰의 서명, 발급자, 대상, 만료 시간, nonce를 검증하고 클레임을 반환합니다.
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken string, nonce string) (*IDTokenClaims, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &IDTokenClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims,
+		func(token *jwt.Token) (interface{}, error) {
+			return p.keys.verifyKey(ctx, p, metadata.JWKSURI, token)
+		},
+		jwt.WithValidMethods(idTokenSigningMethods),
+		jwt.WithIssuer(metadata.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(ID_TOKEN_LEEWAY),
+	)
+	if err != nil {
+		return nil, apperror.ErrOIDCInvalidIDToken
+	}
+
+	if claims.Subject == "" {
+		return nil, apperror.ErrOIDCInvalidIDToken
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, apperror.ErrOIDCInvalidIDToken
+	}
+	// 대상이 여러 개인 토큰은 azp가 이 클라이언트여야 함 (OIDC Core 3.1.3.7)
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.cfg.ClientID {
+		return nil, apperror.ErrOIDCInvalidIDToken
+	}
+
+	return claims, nil
+}
+
+// keySet 구조체는 제공자의 JWKS에서 읽은 서명 키를 kid별로 보관합니다.
+type keySet struct {
+	mu        sync.Mutex
+	keys      map[string]interface{}
+	fetchedAt time.Time
+}
+
+// newKeySet 함수는 비어 있는 keySet을 생성합니다.
+func newKeySet() *keySet {
+	return &keySet{keys: map[string]interface{}{}}
+}
+
+// verifyKey 함수는 토큰의 kid에 해당하는 검증 키를 반환합니다.
+// 모르는 kid이면 제공자가 키를 교체했을 수 있으므로 JWKS를 다시 가져옵니다.
+func (s *keySet) verifyKey(ctx context.Context, p *Provider, jwksURI string, token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.keys[kid]
+	expired := time.Since(s.fetchedAt) > JWKS_CACHE_EXPIRY
+	if (!ok || expired) && time.Since(s.fetchedAt) > JWKS_REFRESH_DELAY {
+		if err := s.fetch(ctx, p, jwksURI); err != nil {
+			return nil, err
+		}
+		key, ok = s.keys[kid]
+	}
+	if !ok {
+		return nil, apperror.ErrOIDCUnknownKeyID
+	}
+
+	// 토큰의 알고리즘과 키 종류가 맞지 않으면 거부
+	switch key.(type) {
+	case *rsa.PublicKey:
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, apperror.ErrOIDCInvalidIDToken
+		}
+	case *ecdsa.PublicKey:
+		if _, ok := token.Method.(*jwt.SigningMethodECDSA); !ok {
+			return nil, apperror.ErrOIDCInvalidIDToken
+		}
+	case ed25519.PublicKey:
+		if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
+			return nil, apperror.ErrOIDCInvalidIDToken
+		}
+	}
+	return key, nil
+}
+
+// jsonWebKey 구조체는 JWKS 응답의 키 하나입니다.
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	Crv     string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+// fetch 함수는 JWKS를 가져와 서명용 공개 키 목록을 교체합니다. 해석할 수 없는 키는 건너뜁니다.
+func (s *keySet) fetch(ctx context.Context, p *Provider, jwksURI string) error {
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(ctx, jwksURI, &jwks); err != nil {
+		return apperror.ErrOIDCDiscoveryFailed
+	}
+
+	keys := map[string]interface{}{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.KeyID] = key
+		}
+	}
+
+	s.keys = keys
+	s.fetchedAt = time.Now()
+	return nil
+}
+
+// publicKey 함수는 JWK를 RSA, P-256 ECDSA 또는 Ed25519 공개 키로 변환합니다.
+func (k *jsonWebKey) publicKey() (interface{}, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, apperror.ErrOIDCInvalidIDToken
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, apperror.ErrOIDCInvalidIDToken
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, apperror.ErrOIDCInvalidIDToken
+		}
+		return key, nil
+	case "OKP":
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if k.Crv != "Ed25519" || len(x) != ed25519.PublicKeySize {
+			return nil, apperror.ErrOIDCInvalidIDToken
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, apperror.ErrOIDCInvalidIDToken
+	}
+}
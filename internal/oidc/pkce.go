@@ -0,0 +1,38 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+const (
+	PKCE_METHOD_S256    = "S256"
+	PKCE_VERIFIER_BYTES = 32 // base64url 인코딩 후 43자 (RFC 7636 최소 길이)
+	RANDOM_VALUE_BYTES  = 32 // state, nonce 길이
+)
+
+// GenerateCodeVerifier 함수는 PKCE code_verifier로 사용할 임의의 문자열을 생성합니다.
+func GenerateCodeVerifier() (string, error) {
+	return randomString(PKCE_VERIFIER_BYTES)
+}
+
+// CodeChallengeS256 함수는 code_verifier의 SHA-256 해시를 base64url로 인코딩한 code_challenge를 반환합니다.
+func CodeChallengeS256(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// GenerateState 함수는 인가 요청의 state 또는 nonce로 사용할 임의의 문자열을 생성합니다.
+func GenerateState() (string, error) {
+	return randomString(RANDOM_VALUE_BYTES)
+}
+
+// randomString 함수는 n바이트 난수를 base64url로 인코딩하여 반환합니다.
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
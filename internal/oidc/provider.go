@@ -0,0 +1,206 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+const (
+	DISCOVERY_PATH = "/.well-known/openid-configuration"
+
+	HTTP_TIMEOUT      = 10 * time.Second
+	MAX_RESPONSE_SIZE = 1 << 20 // 제공자 응답 최대 크기 (1MB)
+)
+
+// providerMetadata 구조체는 discovery 문서에서 사용하는 항목입니다.
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider 구조체는 외부 OpenID Connect 로그인 제공자 하나를 나타냅니다.
+// discovery 문서와 서명 키는 처음 필요할 때 가져와 보관합니다.
+type Provider struct {
+	cfg    config.OIDCProvider
+	client *http.Client
+
+	mu       sync.Mutex
+	metadata *providerMetadata
+	keys     *keySet
+}
+
+var (
+	providers_once     sync.Once
+	providers_instance map[string]*Provider
+)
+
+// GetProvider 함수는 설정된 외부 로그인 제공자를 이름으로 찾습니다.
+func GetProvider(name string) (*Provider, error) {
+	providers_once.Do(func() {
+		providers_instance = map[string]*Provider{}
+		for providerName, cfg := range config.GetConfig().OIDCProviders {
+			providers_instance[providerName] = NewProvider(cfg, &http.Client{Timeout: HTTP_TIMEOUT})
+		}
+	})
+
+	provider, ok := providers_instance[strings.ToLower(name)]
+	if !ok {
+		return nil, apperror.ErrOIDCProviderNotFound
+	}
+	return provider, nil
+}
+
+// NewProvider 함수는 설정과 HTTP 클라이언트로 Provider를 생성합니다.
+func NewProvider(cfg config.OIDCProvider, client *http.Client) *Provider {
+	return &Provider{cfg: cfg, client: client, keys: newKeySet()}
+}
+
+// Name 함수는 제공자 이름을 반환합니다.
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+// discover 함수는 제공자의 엔드포인트 정보를 반환합니다.
+// 설정에 엔드포인트가 모두 지정되어 있으면 그 값을, 아니면 discovery 문서의 값을 사용합니다.
+func (p *Provider) discover(ctx context.Context) (*providerMetadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+	if p.cfg.Issuer == "" || p.cfg.ClientID == "" || p.cfg.RedirectURL == "" {
+		return nil, apperror.ErrOIDCProviderMisconfigured
+	}
+
+	if p.cfg.AuthURL != "" && p.cfg.TokenURL != "" && p.cfg.JWKSURL != "" {
+		p.metadata = &providerMetadata{
+			Issuer:                p.cfg.Issuer,
+			AuthorizationEndpoint: p.cfg.AuthURL,
+			TokenEndpoint:         p.cfg.TokenURL,
+			JWKSURI:               p.cfg.JWKSURL,
+		}
+		return p.metadata, nil
+	}
+
+	var metadata providerMetadata
+	if err := p.getJSON(ctx, p.cfg.Issuer+DISCOVERY_PATH, &metadata); err != nil {
+		return nil, apperror.ErrOIDCDiscoveryFailed
+	}
+	// 다른 발급자를 사칭하는 문서를 쓰지 않도록 issuer가 설정과 같은지 확인
+	if strings.TrimSuffix(metadata.Issuer, "/") != p.cfg.Issuer || metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return nil, apperror.ErrOIDCDiscoveryFailed
+	}
+
+	p.metadata = &metadata
+	return p.metadata, nil
+}
+
+// AuthCodeURL 함수는 사용자를 보낼 제공자의 인가 요청 주소를 만듭니다.
+// state는 CSRF 방지, nonce는 ID 토큰 재사용 방지, codeChallenge는 PKCE(S256)에 사용합니다.
+func (p *Provider) AuthCodeURL(ctx context.Context, state string, nonce string, codeChallenge string) (string, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(metadata.AuthorizationEndpoint)
+	if err != nil {
+		return "", apperror.ErrOIDCProviderMisconfigured
+	}
+
+	query := authURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.cfg.ClientID)
+	query.Set("redirect_uri", p.cfg.RedirectURL)
+	query.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", PKCE_METHOD_S256)
+	authURL.RawQuery = query.Encode()
+
+	return authURL.String(), nil
+}
+
+// tokenResponse 구조체는 토큰 엔드포인트 응답입니다.
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// Exchange 함수는 인가 코드와 PKCE code_verifier를 제공자의 토큰으로 교환하고 ID 토큰 원문을 반환합니다.
+func (p *Provider) Exchange(ctx context.Context, code string, codeVerifier string) (string, error) {
+	metadata, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("client_id", p.cfg.ClientID)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", apperror.ErrOIDCTokenExchangeFailed
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// client_secret_basic 방식 (RFC 6749 2.3.1에 따라 form 인코딩 후 Basic 인증)
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return "", apperror.ErrOIDCTokenExchangeFailed
+	}
+	defer res.Body.Close()
+
+	var token tokenResponse
+	if err := json.NewDecoder(io.LimitReader(res.Body, MAX_RESPONSE_SIZE)).Decode(&token); err != nil {
+		return "", apperror.ErrOIDCTokenExchangeFailed
+	}
+	if res.StatusCode != http.StatusOK || token.Error != "" || token.IDToken == "" {
+		return "", apperror.ErrOIDCTokenExchangeFailed
+	}
+
+	return token.IDToken, nil
+}
+
+// getJSON 함수는 주소의 JSON 응답을 읽어 v에 저장합니다.
+func (p *Provider) getJSON(ctx context.Context, rawURL string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return apperror.ErrOIDCDiscoveryFailed
+	}
+	return json.NewDecoder(io.LimitReader(res.Body, MAX_RESPONSE_SIZE)).Decode(v)
+}
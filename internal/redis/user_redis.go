@@ -2,24 +2,143 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/internal/model"
 	"github.com/jhphon0730/dairify/pkg/apperror"
 	"github.com/jhphon0730/dairify/pkg/utils"
 )
 
 const (
-	USER_TOKEN_KEY = "user:%d:token"
+	USER_SESSION_KEY  = "user:%d:session:%s" // 세션 ID별 세션 정보 (Hash)
+	USER_SESSIONS_KEY = "user:%d:sessions"   // 사용자의 세션 ID 목록 (Set)
+
+	USER_REFRESH_FAMILY_KEY = "user:%d:refresh:%s" // 리프레시 토큰 패밀리별 현재 유효한 토큰 ID
+
+	PASSWORD_RESET_KEY      = "password_reset:%s"      // 재설정 토큰 해시별 사용자 ID
+	USER_PASSWORD_RESET_KEY = "user:%d:password_reset" // 사용자별 현재 유효한 재설정 토큰 해시
+
+	USER_EMAIL_VERIFY_COOLDOWN_KEY = "user:%d:email_verify:cooldown" // 인증 메일 재발송 대기 시간
+
+	USER_DISABLED_KEY = "user:%d:disabled" // 관리자가 비활성화한 계정 표시 (인증 미들웨어에서 확인)
+
+	USER_RECENT_SEARCH_TERMS_KEY = "user:%d:search:recent_terms" // 최근 검색어 (Sorted Set, score = 검색 시각)
+
+	MFA_PENDING_KEY    = "mfa_pending:%s"       // 2단계 인증 대기 중인 로그인 정보 (Hash)
+	USER_TOTP_USED_KEY = "user:%d:totp_used:%d" // 이미 사용된 TOTP 주기(counter), 재사용 방지
+
+	OIDC_STATE_KEY = "oidc_state:%s" // 외부 로그인 state별 요청 정보 (Hash)
+
+	SIGNIN_FAILURE_KEY       = "signin:failure:%s:%s"       // 범위(username, ip)별 로그인 실패 횟수
+	SIGNIN_LOCK_KEY          = "signin:lock:%s:%s"          // 범위별 로그인 잠금 (TTL = 남은 잠금 시간)
+	SIGNIN_LOCKOUT_LEVEL_KEY = "signin:lockout_level:%s:%s" // 범위별 연속 잠금 횟수 (잠금 시간 지수 증가에 사용)
+)
+
+// 로그인 실패 집계 범위
+const (
+	SIGNIN_SCOPE_USERNAME = model.SIGNIN_LOCKOUT_SCOPE_USERNAME
+	SIGNIN_SCOPE_IP       = model.SIGNIN_LOCKOUT_SCOPE_IP
+)
+
+// 세션 Hash 필드명
+const (
+	SESSION_FIELD_DEVICE_NAME  = "device_name"
+	SESSION_FIELD_IP           = "ip"
+	SESSION_FIELD_USER_AGENT   = "user_agent"
+	SESSION_FIELD_CREATED_AT   = "created_at"
+	SESSION_FIELD_LAST_SEEN_AT = "last_seen_at"
+	SESSION_FIELD_READ_ONLY    = "read_only"
+
+	MFA_PENDING_FIELD_USER_ID  = "user_id"
+	MFA_PENDING_FIELD_ATTEMPTS = "attempts"
+
+	OIDC_STATE_FIELD_PROVIDER      = "provider"
+	OIDC_STATE_FIELD_PURPOSE       = "purpose"
+	OIDC_STATE_FIELD_USER_ID       = "user_id"
+	OIDC_STATE_FIELD_NONCE         = "nonce"
+	OIDC_STATE_FIELD_CODE_VERIFIER = "code_verifier"
 )
 
+// rotateRefreshFamilyScript는 패밀리에 저장된 토큰 ID가 일치할 때만 새 토큰 ID로 교체합니다.
+// 반환값: 1 = 교체 성공, 0 = 토큰 ID 불일치(재사용), -1 = 패밀리 없음
+var rotateRefreshFamilyScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if not current then
+	return -1
+end
+if current ~= ARGV[1] then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
+// incrWithExpiryScript는 카운터를 증가시키고, 처음 생성된 경우에만 만료 시간을 설정합니다.
+// 실패가 계속되어도 집계 구간이 늘어나지 않도록 고정 윈도우로 동작합니다.
+var incrWithExpiryScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// updateSessionScript는 세션이 존재할 때만 필드를 갱신하고, 만료 시간이 지정되면 세션과 세션 목록의 만료 시간을 연장합니다.
+// 폐기되거나 만료된 세션이 TTL 없이 다시 생성되지 않도록 EXISTS 확인과 HSET을 원자적으로 수행합니다.
+// KEYS[1] = 세션 키, KEYS[2] = 세션 목록 키, ARGV[1] = 만료 시간(ms, 0이면 유지), ARGV[2..] = 필드/값 쌍
+// 반환값: 1 = 갱신 성공, 0 = 세션 없음
+var updateSessionScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], unpack(ARGV, 2))
+local expiry = tonumber(ARGV[1])
+if expiry > 0 then
+	redis.call('PEXPIRE', KEYS[1], expiry)
+	redis.call('PEXPIRE', KEYS[2], expiry)
+end
+return 1
+`)
+
 // UserRedis 인터페이스는 사용자 관련 Redis 작업을 정의합니다.
 type UserRedis interface {
-	SetUserToken(ctx context.Context, userID int64, token string) error
-	GetUserToken(ctx context.Context, userID int64) (string, error)
-	DeleteUserToken(ctx context.Context, userID int64) error
+	CreateSession(ctx context.Context, session *model.Session) error
+	GetSession(ctx context.Context, userID int64, sessionID string) (*model.Session, error)
+	ListSessions(ctx context.Context, userID int64) ([]*model.Session, error)
+	TouchSession(ctx context.Context, userID int64, sessionID string) error
+	RefreshSession(ctx context.Context, userID int64, sessionID string, ip string, userAgent string) error
+	SetUserSessionsReadOnly(ctx context.Context, userID int64, readOnly bool) error
+	DeleteSession(ctx context.Context, userID int64, sessionID string) error
+	DeleteUserSessions(ctx context.Context, userID int64, exceptSessionID string) error
+	SetUserDisabled(ctx context.Context, userID int64, expiry time.Duration) error
+	DeleteUserDisabled(ctx context.Context, userID int64) error
+	IsUserDisabled(ctx context.Context, userID int64) (bool, error)
+	AddRecentSearchTerms(ctx context.Context, userID int64, terms []string, maxTerms int, expiry time.Duration) error
+	ListRecentSearchTerms(ctx context.Context, userID int64, limit int) ([]string, error)
+	SetRefreshFamily(ctx context.Context, userID int64, familyID string, tokenID string) error
+	RotateRefreshFamily(ctx context.Context, userID int64, familyID string, oldTokenID string, newTokenID string) error
+	DeleteRefreshFamily(ctx context.Context, userID int64, familyID string) error
+	SetPasswordResetToken(ctx context.Context, userID int64, tokenHash string) error
+	GetPasswordResetToken(ctx context.Context, tokenHash string) (int64, error)
+	ConsumePasswordResetToken(ctx context.Context, tokenHash string) (int64, error)
+	AcquireEmailVerifyCooldown(ctx context.Context, userID int64, cooldown time.Duration) (bool, error)
+	SetMFAPending(ctx context.Context, pendingID string, session *model.Session, expiry time.Duration) error
+	GetMFAPending(ctx context.Context, pendingID string) (*model.Session, error)
+	IncrMFAPendingAttempts(ctx context.Context, pendingID string) (int64, error)
+	DeleteMFAPending(ctx context.Context, pendingID string) (bool, error)
+	AcquireTOTPCounter(ctx context.Context, userID int64, counter int64, expiry time.Duration) (bool, error)
+	SetOIDCState(ctx context.Context, state string, loginState *model.OIDCLoginState, expiry time.Duration) error
+	ConsumeOIDCState(ctx context.Context, state string) (*model.OIDCLoginState, error)
+	GetSigninLock(ctx context.Context, scope string, identifier string) (time.Duration, error)
+	RecordSigninFailure(ctx context.Context, scope string, identifier string, window time.Duration) (int64, error)
+	IncrSigninLockoutLevel(ctx context.Context, scope string, identifier string, levelExpiry time.Duration) (int64, error)
+	LockSignin(ctx context.Context, scope string, identifier string, duration time.Duration) error
+	ClearSigninFailures(ctx context.Context, scope string, identifier string) error
 	Close() error
 }
 
@@ -74,31 +193,429 @@ func GetUserRedis(ctx context.Context) (UserRedis, error) {
 	return user_instance, nil
 }
 
-// SetUserToken 함수는 사용자 토큰을 Redis에 저장합니다.
-func (r *userRedis) SetUserToken(ctx context.Context, userID int64, token string) error {
-	key := fmt.Sprintf(USER_TOKEN_KEY, userID)
-	return r.client.Set(ctx, key, token, config.GetConfig().Redis.AccessTokenExpiry).Err()
+// CreateSession 함수는 새로운 세션을 저장하고 사용자의 세션 목록에 추가합니다.
+func (r *userRedis) CreateSession(ctx context.Context, session *model.Session) error {
+	key := fmt.Sprintf(USER_SESSION_KEY, session.UserID, session.ID)
+	setKey := fmt.Sprintf(USER_SESSIONS_KEY, session.UserID)
+	expiry := config.GetConfig().Redis.RefreshTokenExpiry
+
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key, map[string]interface{}{
+		SESSION_FIELD_DEVICE_NAME:  session.DeviceName,
+		SESSION_FIELD_IP:           session.IP,
+		SESSION_FIELD_USER_AGENT:   session.UserAgent,
+		SESSION_FIELD_CREATED_AT:   session.CreatedAt.Unix(),
+		SESSION_FIELD_LAST_SEEN_AT: session.LastSeenAt.Unix(),
+		SESSION_FIELD_READ_ONLY:    session.ReadOnly,
+	})
+	pipe.Expire(ctx, key, expiry)
+	pipe.SAdd(ctx, setKey, session.ID)
+	pipe.Expire(ctx, setKey, expiry)
+	_, err := pipe.Exec(ctx)
+	return err
 }
 
-// GetUserToken 함수는 사용자 ID에 해당하는 토큰을 Redis에서 조회합니다.
-func (r *userRedis) GetUserToken(ctx context.Context, userID int64) (string, error) {
-	key := fmt.Sprintf(USER_TOKEN_KEY, userID)
-	token, err := r.client.Get(ctx, key).Result()
-	if err == redis.Nil {
-		return "", apperror.ErrAuthRequiredToken
+// GetSession 함수는 세션 ID에 해당하는 세션 정보를 조회합니다.
+func (r *userRedis) GetSession(ctx context.Context, userID int64, sessionID string) (*model.Session, error) {
+	key := fmt.Sprintf(USER_SESSION_KEY, userID, sessionID)
+	values, err := r.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, apperror.ErrUserRedisSessionNotFound
+	}
+
+	return &model.Session{
+		ID:         sessionID,
+		UserID:     userID,
+		DeviceName: values[SESSION_FIELD_DEVICE_NAME],
+		IP:         values[SESSION_FIELD_IP],
+		UserAgent:  values[SESSION_FIELD_USER_AGENT],
+		CreatedAt:  time.Unix(utils.InterfaceToInt64(values[SESSION_FIELD_CREATED_AT]), 0),
+		LastSeenAt: time.Unix(utils.InterfaceToInt64(values[SESSION_FIELD_LAST_SEEN_AT]), 0),
+		ReadOnly:   utils.InterfaceToBool(values[SESSION_FIELD_READ_ONLY]),
+	}, nil
+}
+
+// ListSessions 함수는 사용자의 모든 유효한 세션을 조회합니다. 만료된 세션 ID는 목록에서 정리합니다.
+func (r *userRedis) ListSessions(ctx context.Context, userID int64) ([]*model.Session, error) {
+	setKey := fmt.Sprintf(USER_SESSIONS_KEY, userID)
+	sessionIDs, err := r.client.SMembers(ctx, setKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*model.Session, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		session, err := r.GetSession(ctx, userID, sessionID)
+		if errors.Is(err, apperror.ErrUserRedisSessionNotFound) {
+			r.client.SRem(ctx, setKey, sessionID)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
+
+// TouchSession 함수는 세션의 마지막 사용 시각을 갱신합니다. 세션이 없으면 ErrUserRedisSessionNotFound를 반환합니다.
+func (r *userRedis) TouchSession(ctx context.Context, userID int64, sessionID string) error {
+	return r.updateSession(ctx, userID, sessionID, 0, SESSION_FIELD_LAST_SEEN_AT, time.Now().Unix())
+}
+
+// RefreshSession 함수는 토큰 재발급 시 세션의 접속 정보와 만료 시간을 갱신합니다. 세션이 없으면 ErrUserRedisSessionNotFound를 반환합니다.
+func (r *userRedis) RefreshSession(ctx context.Context, userID int64, sessionID string, ip string, userAgent string) error {
+	expiry := config.GetConfig().Redis.RefreshTokenExpiry
+	return r.updateSession(ctx, userID, sessionID, expiry,
+		SESSION_FIELD_IP, ip,
+		SESSION_FIELD_USER_AGENT, userAgent,
+		SESSION_FIELD_LAST_SEEN_AT, time.Now().Unix(),
+	)
+}
+
+// SetUserSessionsReadOnly 함수는 사용자의 모든 세션의 읽기 전용 여부를 변경합니다.
+// 목록 조회 이후 폐기되거나 만료된 세션은 건너뜁니다.
+func (r *userRedis) SetUserSessionsReadOnly(ctx context.Context, userID int64, readOnly bool) error {
+	sessions, err := r.ListSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		err := r.updateSession(ctx, userID, session.ID, 0, SESSION_FIELD_READ_ONLY, readOnly)
+		if err != nil && !errors.Is(err, apperror.ErrUserRedisSessionNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateSession 함수는 세션이 존재할 때만 필드를 갱신합니다. expiry가 0보다 크면 세션과 세션 목록의 만료 시간도 연장합니다.
+func (r *userRedis) updateSession(ctx context.Context, userID int64, sessionID string, expiry time.Duration, fieldValues ...interface{}) error {
+	keys := []string{
+		fmt.Sprintf(USER_SESSION_KEY, userID, sessionID),
+		fmt.Sprintf(USER_SESSIONS_KEY, userID),
 	}
+	args := append([]interface{}{expiry.Milliseconds()}, fieldValues...)
+
+	result, err := updateSessionScript.Run(ctx, r.client, keys, args...).Int()
 	if err != nil {
-		return "", err
+		return err
+	}
+	if result == 0 {
+		return apperror.ErrUserRedisSessionNotFound
 	}
-	return token, nil
+	return nil
+}
+
+// DeleteSession 함수는 세션과 해당 세션의 리프레시 토큰 패밀리를 삭제합니다.
+func (r *userRedis) DeleteSession(ctx context.Context, userID int64, sessionID string) error {
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, fmt.Sprintf(USER_SESSION_KEY, userID, sessionID))
+	pipe.Del(ctx, fmt.Sprintf(USER_REFRESH_FAMILY_KEY, userID, sessionID))
+	pipe.SRem(ctx, fmt.Sprintf(USER_SESSIONS_KEY, userID), sessionID)
+	_, err := pipe.Exec(ctx)
+	return err
 }
 
-// DeleteUserToken 함수는 사용자 ID에 해당하는 토큰을 Redis에서 삭제합니다.
-func (r *userRedis) DeleteUserToken(ctx context.Context, userID int64) error {
-	key := fmt.Sprintf(USER_TOKEN_KEY, userID)
+// DeleteUserSessions 함수는 사용자의 모든 세션을 삭제합니다. exceptSessionID가 지정되면 해당 세션은 유지합니다.
+func (r *userRedis) DeleteUserSessions(ctx context.Context, userID int64, exceptSessionID string) error {
+	setKey := fmt.Sprintf(USER_SESSIONS_KEY, userID)
+	sessionIDs, err := r.client.SMembers(ctx, setKey).Result()
+	if err != nil {
+		return err
+	}
+
+	for _, sessionID := range sessionIDs {
+		if sessionID == exceptSessionID {
+			continue
+		}
+		if err := r.DeleteSession(ctx, userID, sessionID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetUserDisabled 함수는 계정이 비활성화되었음을 표시합니다.
+// 비활성화 이전에 발급된 토큰이 남아 있을 수 있는 기간(expiry) 동안만 유지합니다.
+func (r *userRedis) SetUserDisabled(ctx context.Context, userID int64, expiry time.Duration) error {
+	return r.client.Set(ctx, fmt.Sprintf(USER_DISABLED_KEY, userID), 1, expiry).Err()
+}
+
+// DeleteUserDisabled 함수는 계정 비활성화 표시를 삭제합니다.
+func (r *userRedis) DeleteUserDisabled(ctx context.Context, userID int64) error {
+	return r.client.Del(ctx, fmt.Sprintf(USER_DISABLED_KEY, userID)).Err()
+}
+
+// IsUserDisabled 함수는 계정이 비활성화 상태로 표시되어 있는지 확인합니다.
+func (r *userRedis) IsUserDisabled(ctx context.Context, userID int64) (bool, error) {
+	count, err := r.client.Exists(ctx, fmt.Sprintf(USER_DISABLED_KEY, userID)).Result()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// AddRecentSearchTerms 함수는 최근 검색어를 기록하고 가장 최근 maxTerms개만 남깁니다. 이미 있는 검색어는 검색 시각만 갱신합니다.
+func (r *userRedis) AddRecentSearchTerms(ctx context.Context, userID int64, terms []string, maxTerms int, expiry time.Duration) error {
+	if len(terms) == 0 {
+		return nil
+	}
+
+	key := fmt.Sprintf(USER_RECENT_SEARCH_TERMS_KEY, userID)
+	now := float64(time.Now().UnixNano())
+	members := make([]*redis.Z, 0, len(terms))
+	for _, term := range terms {
+		members = append(members, &redis.Z{Score: now, Member: term})
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.ZAdd(ctx, key, members...)
+	pipe.ZRemRangeByRank(ctx, key, 0, int64(-maxTerms-1))
+	pipe.Expire(ctx, key, expiry)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ListRecentSearchTerms 함수는 최근 검색어를 최신 순으로 조회합니다.
+func (r *userRedis) ListRecentSearchTerms(ctx context.Context, userID int64, limit int) ([]string, error) {
+	return r.client.ZRevRange(ctx, fmt.Sprintf(USER_RECENT_SEARCH_TERMS_KEY, userID), 0, int64(limit-1)).Result()
+}
+
+// SetRefreshFamily 함수는 새로운 리프레시 토큰 패밀리를 생성하고 현재 유효한 토큰 ID를 저장합니다.
+func (r *userRedis) SetRefreshFamily(ctx context.Context, userID int64, familyID string, tokenID string) error {
+	key := fmt.Sprintf(USER_REFRESH_FAMILY_KEY, userID, familyID)
+	return r.client.Set(ctx, key, tokenID, config.GetConfig().Redis.RefreshTokenExpiry).Err()
+}
+
+// RotateRefreshFamily 함수는 패밀리의 현재 토큰 ID가 oldTokenID와 일치하는 경우에만 newTokenID로 교체합니다.
+func (r *userRedis) RotateRefreshFamily(ctx context.Context, userID int64, familyID string, oldTokenID string, newTokenID string) error {
+	key := fmt.Sprintf(USER_REFRESH_FAMILY_KEY, userID, familyID)
+	ttl := config.GetConfig().Redis.RefreshTokenExpiry.Milliseconds()
+
+	result, err := rotateRefreshFamilyScript.Run(ctx, r.client, []string{key}, oldTokenID, newTokenID, ttl).Int()
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case 1:
+		return nil
+	case 0:
+		return apperror.ErrUserRedisRefreshTokenReused
+	default:
+		return apperror.ErrUserRedisRefreshFamilyNotFound
+	}
+}
+
+// DeleteRefreshFamily 함수는 리프레시 토큰 패밀리를 삭제하여 해당 패밀리의 모든 토큰을 폐기합니다.
+func (r *userRedis) DeleteRefreshFamily(ctx context.Context, userID int64, familyID string) error {
+	key := fmt.Sprintf(USER_REFRESH_FAMILY_KEY, userID, familyID)
 	return r.client.Del(ctx, key).Err()
 }
 
+// SetPasswordResetToken 함수는 비밀번호 재설정 토큰 해시를 저장합니다. 이전에 발급된 토큰은 무효화됩니다.
+func (r *userRedis) SetPasswordResetToken(ctx context.Context, userID int64, tokenHash string) error {
+	userKey := fmt.Sprintf(USER_PASSWORD_RESET_KEY, userID)
+	expiry := config.GetConfig().PasswordResetExpiry
+
+	// 이전 토큰 삭제
+	previous, err := r.client.Get(ctx, userKey).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+
+	pipe := r.client.TxPipeline()
+	if previous != "" {
+		pipe.Del(ctx, fmt.Sprintf(PASSWORD_RESET_KEY, previous))
+	}
+	pipe.Set(ctx, fmt.Sprintf(PASSWORD_RESET_KEY, tokenHash), userID, expiry)
+	pipe.Set(ctx, userKey, tokenHash, expiry)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// GetPasswordResetToken 함수는 재설정 토큰 해시에 해당하는 사용자 ID를 토큰을 소모하지 않고 반환합니다.
+func (r *userRedis) GetPasswordResetToken(ctx context.Context, tokenHash string) (int64, error) {
+	value, err := r.client.Get(ctx, fmt.Sprintf(PASSWORD_RESET_KEY, tokenHash)).Result()
+	if err == redis.Nil {
+		return 0, apperror.ErrUserRedisPasswordResetTokenNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	return utils.InterfaceToInt64(value), nil
+}
+
+// ConsumePasswordResetToken 함수는 재설정 토큰 해시에 해당하는 사용자 ID를 반환하고 토큰을 즉시 삭제합니다.
+func (r *userRedis) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (int64, error) {
+	value, err := r.client.GetDel(ctx, fmt.Sprintf(PASSWORD_RESET_KEY, tokenHash)).Result()
+	if err == redis.Nil {
+		return 0, apperror.ErrUserRedisPasswordResetTokenNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	userID := utils.InterfaceToInt64(value)
+	r.client.Del(ctx, fmt.Sprintf(USER_PASSWORD_RESET_KEY, userID))
+	return userID, nil
+}
+
+// AcquireEmailVerifyCooldown 함수는 인증 메일 재발송 대기 시간을 설정합니다. 이미 대기 중이면 false를 반환합니다.
+func (r *userRedis) AcquireEmailVerifyCooldown(ctx context.Context, userID int64, cooldown time.Duration) (bool, error) {
+	key := fmt.Sprintf(USER_EMAIL_VERIFY_COOLDOWN_KEY, userID)
+	return r.client.SetNX(ctx, key, 1, cooldown).Result()
+}
+
+// SetMFAPending 함수는 비밀번호 확인을 통과하고 2단계 인증을 기다리는 로그인 정보를 저장합니다.
+func (r *userRedis) SetMFAPending(ctx context.Context, pendingID string, session *model.Session, expiry time.Duration) error {
+	key := fmt.Sprintf(MFA_PENDING_KEY, pendingID)
+
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key, map[string]interface{}{
+		MFA_PENDING_FIELD_USER_ID:  session.UserID,
+		SESSION_FIELD_DEVICE_NAME:  session.DeviceName,
+		SESSION_FIELD_IP:           session.IP,
+		SESSION_FIELD_USER_AGENT:   session.UserAgent,
+		SESSION_FIELD_READ_ONLY:    session.ReadOnly,
+		MFA_PENDING_FIELD_ATTEMPTS: 0,
+	})
+	pipe.Expire(ctx, key, expiry)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetMFAPending 함수는 2단계 인증 대기 중인 로그인 정보를 세션 형태로 조회합니다.
+func (r *userRedis) GetMFAPending(ctx context.Context, pendingID string) (*model.Session, error) {
+	values, err := r.client.HGetAll(ctx, fmt.Sprintf(MFA_PENDING_KEY, pendingID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, apperror.ErrUserRedisMFAPendingNotFound
+	}
+
+	return &model.Session{
+		UserID:     utils.InterfaceToInt64(values[MFA_PENDING_FIELD_USER_ID]),
+		DeviceName: values[SESSION_FIELD_DEVICE_NAME],
+		IP:         values[SESSION_FIELD_IP],
+		UserAgent:  values[SESSION_FIELD_USER_AGENT],
+		ReadOnly:   utils.InterfaceToBool(values[SESSION_FIELD_READ_ONLY]),
+	}, nil
+}
+
+// IncrMFAPendingAttempts 함수는 2단계 인증 시도 횟수를 증가시키고 증가된 값을 반환합니다.
+func (r *userRedis) IncrMFAPendingAttempts(ctx context.Context, pendingID string) (int64, error) {
+	return r.client.HIncrBy(ctx, fmt.Sprintf(MFA_PENDING_KEY, pendingID), MFA_PENDING_FIELD_ATTEMPTS, 1).Result()
+}
+
+// DeleteMFAPending 함수는 2단계 인증 대기 정보를 삭제합니다. 실제로 삭제한 경우에만 true를 반환하므로
+// 동시에 들어온 요청 중 하나만 로그인을 완료할 수 있습니다.
+func (r *userRedis) DeleteMFAPending(ctx context.Context, pendingID string) (bool, error) {
+	deleted, err := r.client.Del(ctx, fmt.Sprintf(MFA_PENDING_KEY, pendingID)).Result()
+	if err != nil {
+		return false, err
+	}
+	return deleted == 1, nil
+}
+
+// AcquireTOTPCounter 함수는 TOTP 주기(counter)를 사용 처리합니다. 이미 사용된 주기이면 false를 반환합니다.
+func (r *userRedis) AcquireTOTPCounter(ctx context.Context, userID int64, counter int64, expiry time.Duration) (bool, error) {
+	key := fmt.Sprintf(USER_TOTP_USED_KEY, userID, counter)
+	return r.client.SetNX(ctx, key, 1, expiry).Result()
+}
+
+// SetOIDCState 함수는 외부 로그인 요청의 nonce, PKCE code_verifier 등을 state 값으로 저장합니다.
+func (r *userRedis) SetOIDCState(ctx context.Context, state string, loginState *model.OIDCLoginState, expiry time.Duration) error {
+	key := fmt.Sprintf(OIDC_STATE_KEY, state)
+
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key, map[string]interface{}{
+		OIDC_STATE_FIELD_PROVIDER:      loginState.Provider,
+		OIDC_STATE_FIELD_PURPOSE:       loginState.Purpose,
+		OIDC_STATE_FIELD_USER_ID:       loginState.UserID,
+		OIDC_STATE_FIELD_NONCE:         loginState.Nonce,
+		OIDC_STATE_FIELD_CODE_VERIFIER: loginState.CodeVerifier,
+	})
+	pipe.Expire(ctx, key, expiry)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ConsumeOIDCState 함수는 state 값에 해당하는 외부 로그인 요청 정보를 반환하고 즉시 삭제합니다.
+// 조회와 삭제를 하나의 트랜잭션으로 실행하여 같은 state를 두 번 사용할 수 없게 합니다.
+func (r *userRedis) ConsumeOIDCState(ctx context.Context, state string) (*model.OIDCLoginState, error) {
+	key := fmt.Sprintf(OIDC_STATE_KEY, state)
+
+	pipe := r.client.TxPipeline()
+	get := pipe.HGetAll(ctx, key)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	values := get.Val()
+	if len(values) == 0 {
+		return nil, apperror.ErrUserRedisOIDCStateNotFound
+	}
+
+	return &model.OIDCLoginState{
+		Provider:     values[OIDC_STATE_FIELD_PROVIDER],
+		Purpose:      values[OIDC_STATE_FIELD_PURPOSE],
+		UserID:       utils.InterfaceToInt64(values[OIDC_STATE_FIELD_USER_ID]),
+		Nonce:        values[OIDC_STATE_FIELD_NONCE],
+		CodeVerifier: values[OIDC_STATE_FIELD_CODE_VERIFIER],
+	}, nil
+}
+
+// GetSigninLock 함수는 로그인 잠금의 남은 시간을 반환합니다. 잠겨 있지 않으면 0을 반환합니다.
+func (r *userRedis) GetSigninLock(ctx context.Context, scope string, identifier string) (time.Duration, error) {
+	ttl, err := r.client.PTTL(ctx, fmt.Sprintf(SIGNIN_LOCK_KEY, scope, identifier)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// RecordSigninFailure 함수는 집계 구간 안의 로그인 실패 횟수를 증가시키고 증가된 값을 반환합니다.
+func (r *userRedis) RecordSigninFailure(ctx context.Context, scope string, identifier string, window time.Duration) (int64, error) {
+	key := fmt.Sprintf(SIGNIN_FAILURE_KEY, scope, identifier)
+	return incrWithExpiryScript.Run(ctx, r.client, []string{key}, window.Milliseconds()).Int64()
+}
+
+// IncrSigninLockoutLevel 함수는 연속 잠금 횟수를 증가시키고 증가된 값을 반환합니다.
+// 잠금 시간을 지수적으로 늘리는 데 사용하며, 첫 잠금 이후 levelExpiry가 지나면 초기화됩니다.
+func (r *userRedis) IncrSigninLockoutLevel(ctx context.Context, scope string, identifier string, levelExpiry time.Duration) (int64, error) {
+	key := fmt.Sprintf(SIGNIN_LOCKOUT_LEVEL_KEY, scope, identifier)
+	return incrWithExpiryScript.Run(ctx, r.client, []string{key}, levelExpiry.Milliseconds()).Int64()
+}
+
+// LockSignin 함수는 지정한 시간 동안 로그인을 잠그고 실패 횟수를 초기화합니다.
+func (r *userRedis) LockSignin(ctx context.Context, scope string, identifier string, duration time.Duration) error {
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, fmt.Sprintf(SIGNIN_LOCK_KEY, scope, identifier), 1, duration)
+	pipe.Del(ctx, fmt.Sprintf(SIGNIN_FAILURE_KEY, scope, identifier))
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// ClearSigninFailures 함수는 로그인 성공 시 실패 횟수와 연속 잠금 횟수를 초기화합니다.
+func (r *userRedis) ClearSigninFailures(ctx context.Context, scope string, identifier string) error {
+	return r.client.Del(ctx,
+		fmt.Sprintf(SIGNIN_FAILURE_KEY, scope, identifier),
+		fmt.Sprintf(SIGNIN_LOCKOUT_LEVEL_KEY, scope, identifier),
+	).Err()
+}
+
 // Close 함수는 Redis 클라이언트를 종료합니다.
 func (r *userRedis) Close() error {
 	return r.client.Close()
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// AccountRepository 인터페이스는 계정 단위의 삭제 예약, 내보내기, 영구 삭제 작업을 정의합니다.
+type AccountRepository interface {
+	ScheduleDeletion(ctx context.Context, userID int64, graceSeconds int64) (*model.User, error)
+	CancelDeletion(ctx context.Context, userID int64) error
+	FindUsersDueForDeletion(ctx context.Context) ([]*model.User, error)
+	GetCategoriesForExport(ctx context.Context, userID int64) ([]model.Category, error)
+	GetDiariesForExport(ctx context.Context, userID int64) ([]model.Diary, error)
+	DeleteUserData(ctx context.Context, userID int64) ([]string, error)
+}
+
+// accountRepository 구조체는 AccountRepository 인터페이스를 구현합니다.
+type accountRepository struct {
+	db *database.DB
+}
+
+// NewAccountRepository 함수는 AccountRepository 인터페이스의 구현체를 반환합니다.
+func NewAccountRepository(db *database.DB) AccountRepository {
+	return &accountRepository{db: db}
+}
+
+// ScheduleDeletion 함수는 유예 기간 이후로 계정 삭제를 예약합니다. 이미 예약된 경우 기존 예약 시각을 유지합니다.
+func (r *accountRepository) ScheduleDeletion(ctx context.Context, userID int64, graceSeconds int64) (*model.User, error) {
+	query := `
+		UPDATE users
+		SET deletion_scheduled_at = COALESCE(deletion_scheduled_at, CURRENT_TIMESTAMP + ($2 * INTERVAL '1 second'))
+		WHERE id = $1
+		RETURNING ` + USER_SELECT_COLUMNS
+
+	return scanUser(r.db.DB.QueryRowContext(ctx, query, userID, graceSeconds))
+}
+
+// CancelDeletion 함수는 예약된 계정 삭제를 취소합니다.
+func (r *accountRepository) CancelDeletion(ctx context.Context, userID int64) error {
+	query := `
+		UPDATE users
+		SET deletion_scheduled_at = NULL
+		WHERE id = $1 AND deletion_scheduled_at IS NOT NULL
+	`
+
+	result, err := r.db.DB.ExecContext(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrAccountDeletionNotScheduled
+	}
+
+	return nil
+}
+
+// FindUsersDueForDeletion 함수는 유예 기간이 끝나 삭제해야 하는 사용자 목록을 조회합니다.
+func (r *accountRepository) FindUsersDueForDeletion(ctx context.Context) ([]*model.User, error) {
+	query := `
+		SELECT ` + USER_SELECT_COLUMNS + `
+		FROM users
+		WHERE deletion_scheduled_at IS NOT NULL AND deletion_scheduled_at <= CURRENT_TIMESTAMP
+		ORDER BY deletion_scheduled_at
+	`
+
+	rows, err := r.db.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*model.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return users, rows.Err()
+}
+
+// GetCategoriesForExport 함수는 사용자의 모든 카테고리를 조회합니다.
+func (r *accountRepository) GetCategoriesForExport(ctx context.Context, userID int64) ([]model.Category, error) {
+	query := `
+		SELECT id, name, creator_id, created_at
+		FROM categories
+		WHERE creator_id = $1
+		ORDER BY id
+	`
+
+	rows, err := r.db.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, apperror.ErrAccountExportInternal
+	}
+	defer rows.Close()
+
+	categories := []model.Category{}
+	for rows.Next() {
+		var category model.Category
+		if err := rows.Scan(&category.ID, &category.Name, &category.CreatorID, &category.CreatedAt); err != nil {
+			return nil, apperror.ErrAccountExportInternal
+		}
+		categories = append(categories, category)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.ErrAccountExportInternal
+	}
+
+	return categories, nil
+}
+
+// GetDiariesForExport 함수는 휴지통에 있는 일기를 포함한 사용자의 모든 일기와 태그, 이미지 정보를 조회합니다.
+func (r *accountRepository) GetDiariesForExport(ctx context.Context, userID int64) ([]model.Diary, error) {
+	query := "SELECT " + DIARY_SELECT_COLUMNS + " FROM diaries WHERE creator_id = $1 ORDER BY created_at, id"
+	rows, err := r.db.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, apperror.ErrAccountExportInternal
+	}
+	defer rows.Close()
+
+	diaries := []model.Diary{}
+	indexByID := map[int64]int{}
+	for rows.Next() {
+		var diary model.Diary
+		if err := scanDiary(rows, &diary); err != nil {
+			return nil, apperror.ErrAccountExportInternal
+		}
+		diary.Tags = []string{}
+		indexByID[diary.ID] = len(diaries)
+		diaries = append(diaries, diary)
+	}
+	// 조회 도중 오류가 나면 일부만 내보낸 채 계정이 삭제되지 않도록 실패 처리
+	if err := rows.Err(); err != nil {
+		return nil, apperror.ErrAccountExportInternal
+	}
+	rows.Close()
+
+	// 태그 이름도 한 번에 조회하여 일기별로 분배
+	tagQuery := `
+		SELECT dt.diary_id, t.name
+		FROM diary_tags dt
+		JOIN tags t ON t.id = dt.tag_id
+		WHERE t.creator_id = $1
+		ORDER BY LOWER(t.name)
+	`
+	tagRows, err := r.db.DB.QueryContext(ctx, tagQuery, userID)
+	if err != nil {
+		return nil, apperror.ErrAccountExportInternal
+	}
+	defer tagRows.Close()
+
+	for tagRows.Next() {
+		var diaryID int64
+		var name string
+		if err := tagRows.Scan(&diaryID, &name); err != nil {
+			return nil, apperror.ErrAccountExportInternal
+		}
+		if idx, ok := indexByID[diaryID]; ok {
+			diaries[idx].Tags = append(diaries[idx].Tags, name)
+		}
+	}
+	if err := tagRows.Err(); err != nil {
+		return nil, apperror.ErrAccountExportInternal
+	}
+	tagRows.Close()
+
+	// 이미지 정보는 한 번에 조회하여 일기별로 분배
+	imageQuery := `
+		SELECT i.id, i.diary_id, i.file_path, i.file_name, i.content_type, i.file_size, i.created_at
+		FROM images i
+		JOIN diaries d ON d.id = i.diary_id
+		WHERE d.creator_id = $1
+		ORDER BY i.id
+	`
+	imageRows, err := r.db.DB.QueryContext(ctx, imageQuery, userID)
+	if err != nil {
+		return nil, apperror.ErrAccountExportInternal
+	}
+	defer imageRows.Close()
+
+	for imageRows.Next() {
+		var image model.DiaryImage
+		if err := imageRows.Scan(&image.ID, &image.DiaryID, &image.FilePath, &image.FileName, &image.ContentType, &image.FileSize, &image.CreatedAt); err != nil {
+			return nil, apperror.ErrAccountExportInternal
+		}
+		if idx, ok := indexByID[image.DiaryID]; ok {
+			diaries[idx].Images = append(diaries[idx].Images, &image)
+		}
+	}
+	if err := imageRows.Err(); err != nil {
+		return nil, apperror.ErrAccountExportInternal
+	}
+
+	return diaries, nil
+}
+
+// DeleteUserData 함수는 삭제 예정 시각이 지난 사용자의 모든 데이터를 하나의 트랜잭션으로 영구 삭제하고,
+// 디스크에서 지워야 할 이미지 파일 경로 목록을 반환합니다.
+func (r *accountRepository) DeleteUserData(ctx context.Context, userID int64) ([]string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// 삭제 직전에 취소되지 않았는지 행 잠금과 함께 다시 확인
+	var lockedID int64
+	lockQuery := "SELECT id FROM users WHERE id = $1 AND deletion_scheduled_at IS NOT NULL AND deletion_scheduled_at <= CURRENT_TIMESTAMP FOR UPDATE"
+	if err := tx.QueryRowContext(ctx, lockQuery, userID).Scan(&lockedID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrAccountDeletionCancelled
+		}
+		return nil, err
+	}
+
+	// 디스크에서 삭제할 이미지 경로 수집
+	rows, err := tx.QueryContext(ctx, "SELECT i.file_path FROM images i JOIN diaries d ON d.id = i.diary_id WHERE d.creator_id = $1", userID)
+	if err != nil {
+		return nil, err
+	}
+	var filePaths []string
+	for rows.Next() {
+		var filePath string
+		if err := rows.Scan(&filePath); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		filePaths = append(filePaths, filePath)
+	}
+	rows.Close()
+
+	// 참조 관계 순서대로 삭제 (categories.creator_id에는 ON DELETE 규칙이 없음)
+	queries := []string{
+		"DELETE FROM images WHERE diary_id IN (SELECT id FROM diaries WHERE creator_id = $1)",
+		"DELETE FROM diaries WHERE creator_id = $1",
+		"DELETE FROM categories WHERE creator_id = $1",
+		"DELETE FROM users WHERE id = $1",
+	}
+	for _, query := range queries {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return filePaths, nil
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// AdminRepository 인터페이스는 관리자용 사용자 관리 데이터베이스 작업을 정의합니다.
+type AdminRepository interface {
+	ListUsers(ctx context.Context, listUsersDTO dto.AdminListUsersDTO) ([]*model.AdminUserSummary, int64, error)
+	FindUserSummary(ctx context.Context, userID int64) (*model.AdminUserSummary, error)
+	SetUserDisabled(ctx context.Context, userID int64, disabled bool) error
+}
+
+// ADMIN_USER_USAGE_COLUMNS는 사용자별 사용 현황 컬럼 목록입니다. ADMIN_USER_USAGE_JOINS와 함께 사용해야 합니다.
+const ADMIN_USER_USAGE_COLUMNS = "COALESCE(diary_usage.diary_count, 0), COALESCE(diary_usage.deleted_diary_count, 0), COALESCE(image_usage.image_count, 0), COALESCE(image_usage.storage_bytes, 0)"
+
+// ADMIN_USER_USAGE_JOINS는 users 테이블(별칭 u)에 사용자별 일기 수와 이미지 사용량을 붙이는 조인입니다.
+const ADMIN_USER_USAGE_JOINS = `
+	LEFT JOIN LATERAL (
+		SELECT COUNT(*) FILTER (WHERE NOT diaries.is_deleted) AS diary_count,
+		       COUNT(*) FILTER (WHERE diaries.is_deleted) AS deleted_diary_count
+		FROM diaries
+		WHERE diaries.creator_id = u.id
+	) diary_usage ON TRUE
+	LEFT JOIN LATERAL (
+		SELECT COUNT(*) AS image_count, SUM(images.file_size)::BIGINT AS storage_bytes
+		FROM images
+		JOIN diaries ON diaries.id = images.diary_id
+		WHERE diaries.creator_id = u.id
+	) image_usage ON TRUE
+`
+
+// adminRepository 구조체는 AdminRepository 인터페이스를 구현합니다.
+type adminRepository struct {
+	db *database.DB
+}
+
+// NewAdminRepository 함수는 AdminRepository 인터페이스의 구현체를 반환합니다.
+func NewAdminRepository(db *database.DB) AdminRepository {
+	return &adminRepository{db: db}
+}
+
+// scanAdminUserSummary 함수는 USER_SELECT_COLUMNS, ADMIN_USER_USAGE_COLUMNS 순서로 조회된 행을 model.AdminUserSummary로 변환합니다.
+func scanAdminUserSummary(row rowScanner) (*model.AdminUserSummary, error) {
+	user := &model.User{}
+	summary := &model.AdminUserSummary{User: user}
+	if err := row.Scan(
+		&user.ID, &user.Username, &user.Nickname, &user.Password, &user.Email, &user.EmailVerifiedAt, &user.DeletionScheduledAt, &user.TOTPSecret, &user.TOTPEnabledAt, &user.Role, &user.DisabledAt, &user.Timezone, &user.DiaryRevisionLimit, &user.CreatedAt,
+		&summary.Usage.DiaryCount, &summary.Usage.DeletedDiaryCount, &summary.Usage.ImageCount, &summary.Usage.StorageBytes,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperror.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// escapeLikePattern 함수는 LIKE 검색어의 와일드카드 문자(%, _)가 문자 그대로 검색되도록 이스케이프합니다.
+func escapeLikePattern(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(value)
+}
+
+// buildAdminUserFilter 함수는 사용자 목록 검색 조건과 인자를 만듭니다.
+func buildAdminUserFilter(listUsersDTO dto.AdminListUsersDTO) (string, []interface{}) {
+	conditions := []string{"TRUE"}
+	args := []interface{}{}
+
+	if listUsersDTO.Query != "" {
+		args = append(args, "%"+escapeLikePattern(listUsersDTO.Query)+"%")
+		placeholder := "$" + strconv.Itoa(len(args))
+		conditions = append(conditions, "(u.username ILIKE "+placeholder+" OR u.nickname ILIKE "+placeholder+" OR u.email ILIKE "+placeholder+")")
+	}
+
+	switch listUsersDTO.Status {
+	case model.ADMIN_USER_STATUS_ACTIVE:
+		conditions = append(conditions, "u.disabled_at IS NULL")
+	case model.ADMIN_USER_STATUS_DISABLED:
+		conditions = append(conditions, "u.disabled_at IS NOT NULL")
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// ListUsers 함수는 검색 조건에 맞는 사용자 목록과 사용 현황, 전체 개수를 조회합니다.
+func (r *adminRepository) ListUsers(ctx context.Context, listUsersDTO dto.AdminListUsersDTO) ([]*model.AdminUserSummary, int64, error) {
+	where, args := buildAdminUserFilter(listUsersDTO)
+
+	var total int64
+	if err := r.db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM users u WHERE "+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	limitPlaceholder := "$" + strconv.Itoa(len(args)+1)
+	offsetPlaceholder := "$" + strconv.Itoa(len(args)+2)
+	query := `
+		SELECT ` + USER_SELECT_COLUMNS + `, ` + ADMIN_USER_USAGE_COLUMNS + `
+		FROM users u
+		` + ADMIN_USER_USAGE_JOINS + `
+		WHERE ` + where + `
+		ORDER BY u.id ASC
+		LIMIT ` + limitPlaceholder + ` OFFSET ` + offsetPlaceholder
+
+	args = append(args, listUsersDTO.Limit, listUsersDTO.Offset())
+	rows, err := r.db.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	summaries := []*model.AdminUserSummary{}
+	for rows.Next() {
+		summary, err := scanAdminUserSummary(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, total, rows.Err()
+}
+
+// FindUserSummary 함수는 사용자 정보와 사용 현황을 조회합니다.
+func (r *adminRepository) FindUserSummary(ctx context.Context, userID int64) (*model.AdminUserSummary, error) {
+	query := `
+		SELECT ` + USER_SELECT_COLUMNS + `, ` + ADMIN_USER_USAGE_COLUMNS + `
+		FROM users u
+		` + ADMIN_USER_USAGE_JOINS + `
+		WHERE u.id = $1
+	`
+
+	return scanAdminUserSummary(r.db.DB.QueryRowContext(ctx, query, userID))
+}
+
+// SetUserDisabled 함수는 사용자 계정을 비활성화하거나 다시 활성화합니다.
+// 이미 비활성화된 계정은 최초 비활성화 시각을 유지합니다.
+func (r *adminRepository) SetUserDisabled(ctx context.Context, userID int64, disabled bool) error {
+	query := `
+		UPDATE users
+		SET disabled_at = CASE WHEN $2 THEN COALESCE(disabled_at, CURRENT_TIMESTAMP) ELSE NULL END
+		WHERE id = $1
+	`
+
+	result, err := r.db.DB.ExecContext(ctx, query, userID, disabled)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrUserNotFound
+	}
+
+	return nil
+}
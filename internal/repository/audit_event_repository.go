@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+)
+
+// AuditEventRepository 인터페이스는 보안 감사 로그 관련 데이터베이스 작업을 정의합니다.
+// 감사 로그는 추가만 가능하므로 수정, 삭제 메서드는 제공하지 않습니다.
+type AuditEventRepository interface {
+	CreateEvent(ctx context.Context, event *model.AuditEvent) error
+	ListEvents(ctx context.Context, listDTO dto.AuditEventListDTO) ([]*model.AuditEvent, int64, error)
+}
+
+// AUDIT_EVENT_SELECT_COLUMNS는 감사 로그 조회 시 공통으로 사용하는 컬럼 목록입니다. scanAuditEvent의 순서와 일치해야 합니다.
+const AUDIT_EVENT_SELECT_COLUMNS = "id, user_id, actor_id, event_type, ip, user_agent, metadata, created_at"
+
+// auditEventRepository 구조체는 AuditEventRepository 인터페이스를 구현합니다.
+type auditEventRepository struct {
+	db *database.DB
+}
+
+// NewAuditEventRepository 함수는 AuditEventRepository 인터페이스의 구현체를 반환합니다.
+func NewAuditEventRepository(db *database.DB) AuditEventRepository {
+	return &auditEventRepository{db: db}
+}
+
+// scanAuditEvent 함수는 AUDIT_EVENT_SELECT_COLUMNS 순서로 조회된 행을 model.AuditEvent로 변환합니다.
+func scanAuditEvent(row rowScanner) (*model.AuditEvent, error) {
+	event := &model.AuditEvent{}
+	var metadata []byte
+	if err := row.Scan(&event.ID, &event.UserID, &event.ActorID, &event.Type, &event.IP, &event.UserAgent, &metadata, &event.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	if len(metadata) > 0 {
+		if err := json.Unmarshal(metadata, &event.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	return event, nil
+}
+
+// CreateEvent 함수는 감사 로그 한 건을 추가합니다.
+func (r *auditEventRepository) CreateEvent(ctx context.Context, event *model.AuditEvent) error {
+	metadata := []byte("{}")
+	if len(event.Metadata) > 0 {
+		encoded, err := json.Marshal(event.Metadata)
+		if err != nil {
+			return err
+		}
+		metadata = encoded
+	}
+
+	query := `
+		INSERT INTO audit_events (user_id, actor_id, event_type, ip, user_agent, metadata)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+
+	return r.db.DB.QueryRowContext(ctx, query, event.UserID, event.ActorID, event.Type, event.IP, event.UserAgent, string(metadata)).Scan(&event.ID, &event.CreatedAt)
+}
+
+// ListEvents 함수는 조건에 맞는 감사 로그를 최신순으로 조회하고 전체 개수를 함께 반환합니다.
+func (r *auditEventRepository) ListEvents(ctx context.Context, listDTO dto.AuditEventListDTO) ([]*model.AuditEvent, int64, error) {
+	conditions := []string{"TRUE"}
+	args := []interface{}{}
+
+	if listDTO.UserID != nil {
+		args = append(args, *listDTO.UserID)
+		conditions = append(conditions, "user_id = $"+strconv.Itoa(len(args)))
+	}
+	if listDTO.Type != "" {
+		args = append(args, listDTO.Type)
+		conditions = append(conditions, "event_type = $"+strconv.Itoa(len(args)))
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := r.db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM audit_events WHERE "+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT ` + AUDIT_EVENT_SELECT_COLUMNS + `
+		FROM audit_events
+		WHERE ` + where + `
+		ORDER BY id DESC
+		LIMIT $` + strconv.Itoa(len(args)+1) + ` OFFSET $` + strconv.Itoa(len(args)+2)
+
+	args = append(args, listDTO.Limit, listDTO.Offset())
+	rows, err := r.db.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	events := []*model.AuditEvent{}
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		events = append(events, event)
+	}
+
+	return events, total, rows.Err()
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 
 	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/dto"
 	"github.com/jhphon0730/dairify/internal/model"
 	"github.com/jhphon0730/dairify/pkg/apperror"
 )
@@ -13,7 +14,9 @@ import (
 // CategoryRepository 인터페이스는 카테고리 관련 데이터베이스 작업을 정의합니다.
 type CategoryRepository interface {
 	CreateCategory(ctx context.Context, category *model.Category) error
-	GetCategoriesByCreatorID(ctx context.Context, creatorID int64) ([]model.Category, error)
+	GetCategoriesByCreatorID(ctx context.Context, creatorID int64, page dto.CursorPageDTO) ([]model.Category, error)
+	CountCategoriesByCreatorID(ctx context.Context, creatorID int64) (int64, error)
+	FindCategoryNamesByPrefix(ctx context.Context, creatorID int64, prefix string, limit int) ([]string, error)
 	GetCategoryByID(ctx context.Context, id int64, creatorID int64) (*model.Category, error)
 	UpdateCategoryName(ctx context.Context, category *model.Category) error
 	DeleteCategory(ctx context.Context, categoryID int64, creatorID int64) error
@@ -48,20 +51,21 @@ func (r *categoryRepository) CreateCategory(ctx context.Context, category *model
 	return nil
 }
 
-// GetCategoriesByCreatorID 함수는 주어진 생성자 ID로 카테고리 목록을 조회합니다.
-func (r *categoryRepository) GetCategoriesByCreatorID(ctx context.Context, creatorID int64) ([]model.Category, error) {
-	var categories []model.Category
+// GetCategoriesByCreatorID 함수는 주어진 생성자 ID로 카테고리 목록을 (created_at, id) 커서 기준으로 한 페이지 조회합니다.
+// 다음 페이지 존재 여부를 판단할 수 있도록 page.Limit보다 한 건 더 조회하며, 이전 페이지 방향이면 오래된 순으로 반환합니다.
+func (r *categoryRepository) GetCategoriesByCreatorID(ctx context.Context, creatorID int64, page dto.CursorPageDTO) ([]model.Category, error) {
+	categories := []model.Category{}
+
+	keyset, args := buildKeysetClause(page, 2)
 	query := `
 		SELECT id, name, creator_id, created_at
 		FROM categories
-		WHERE creator_id = $1
-		ORDER BY created_at DESC
-	`
+		WHERE creator_id = $1` + keyset
 
-	rows, err := r.db.DB.QueryContext(ctx, query, creatorID)
+	rows, err := r.db.DB.QueryContext(ctx, query, append([]interface{}{creatorID}, args...)...)
 	// 만약 카테고리가 존재하지 않는다면, 빈 슬라이스를 반환합니다.
 	if errors.Is(err, sql.ErrNoRows) {
-		return nil, nil
+		return categories, nil
 	}
 	if err != nil {
 		return nil, apperror.ErrGetFailedInternalServerError
@@ -75,10 +79,54 @@ func (r *categoryRepository) GetCategoriesByCreatorID(ctx context.Context, creat
 		}
 		categories = append(categories, category)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.ErrGetFailedInternalServerError
+	}
 
 	return categories, nil
 }
 
+// CountCategoriesByCreatorID 함수는 주어진 생성자 ID의 카테고리 전체 개수를 조회합니다.
+func (r *categoryRepository) CountCategoriesByCreatorID(ctx context.Context, creatorID int64) (int64, error) {
+	var total int64
+	if err := r.db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM categories WHERE creator_id = $1", creatorID).Scan(&total); err != nil {
+		return 0, apperror.ErrGetFailedInternalServerError
+	}
+
+	return total, nil
+}
+
+// FindCategoryNamesByPrefix 함수는 주어진 접두어로 시작하는 카테고리 이름을 대소문자 구분 없이 이름순으로 조회합니다.
+func (r *categoryRepository) FindCategoryNamesByPrefix(ctx context.Context, creatorID int64, prefix string, limit int) ([]string, error) {
+	query := `
+		SELECT name
+		FROM categories
+		WHERE creator_id = $1 AND name ILIKE $2
+		ORDER BY name ASC
+		LIMIT $3
+	`
+
+	rows, err := r.db.DB.QueryContext(ctx, query, creatorID, escapeLikePattern(prefix)+"%", limit)
+	if err != nil {
+		return nil, apperror.ErrGetFailedInternalServerError
+	}
+	defer rows.Close()
+
+	names := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, apperror.ErrGetFailedInternalServerError
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.ErrGetFailedInternalServerError
+	}
+
+	return names, nil
+}
+
 // FindCategoryByID 함수는 주어진 ID와 생성자 ID로 카테고리를 조회합니다.
 func (r *categoryRepository) GetCategoryByID(ctx context.Context, id int64, creatorID int64) (*model.Category, error) {
 	query := `
@@ -126,17 +174,38 @@ func (r *categoryRepository) UpdateCategoryName(ctx context.Context, category *m
 
 // DeleteCategory 함수는 카테고리를 삭제합니다.
 func (r *categoryRepository) DeleteCategory(ctx context.Context, categoryID int64, creatorID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperror.ErrCategoryDeleteForbidden
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// 카테고리가 삭제되면 일기의 카테고리가 해제되므로 (ON DELETE SET NULL) 해당 일기의 버전을 올림
+	bumpVersions := `
+		UPDATE diaries
+		SET version = version + 1
+		WHERE category_id = $1 AND creator_id = $2
+	`
+	if _, err := tx.ExecContext(ctx, bumpVersions, categoryID, creatorID); err != nil {
+		return apperror.ErrCategoryDeleteForbidden
+	}
+
 	query := `
 		DELETE FROM categories
 		WHERE id = $1 AND creator_id = $2
 	`
 
-	if _, err := r.db.DB.ExecContext(ctx, query, categoryID, creatorID); err != nil {
+	if _, err := tx.ExecContext(ctx, query, categoryID, creatorID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return apperror.ErrCategoryNotFound
 		}
 		return apperror.ErrCategoryDeleteForbidden
 	}
 
+	if err := tx.Commit(); err != nil {
+		return apperror.ErrCategoryDeleteForbidden
+	}
 	return nil
 }
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+)
+
+// GetDiaryCalendar 함수는 한 달 동안 작성한 일기를 사용자 시간대의 날짜별로 집계합니다. 일기가 없는 날짜는 포함하지 않습니다.
+// created_at은 DB 세션 시간대 기준 시각이므로 timestamptz로 바꾼 뒤 사용자 시간대의 날짜로 변환합니다.
+func (r *diaryRepository) GetDiaryCalendar(ctx context.Context, creatorID int64, calendarDTO dto.DiaryCalendarDTO) ([]*model.DiaryCalendarDay, error) {
+	start, end := calendarDTO.Range()
+	query := `
+		SELECT TO_CHAR(created_at::timestamptz AT TIME ZONE $2, 'YYYY-MM-DD') AS day,
+		       COUNT(*),
+		       (ARRAY_AGG(title ORDER BY created_at ASC, id ASC))[1],
+		       BOOL_OR(EXISTS (SELECT 1 FROM images WHERE images.diary_id = diaries.id))
+		FROM diaries
+		WHERE creator_id = $1 AND is_deleted = FALSE
+		  AND created_at >= $3::timestamptz::timestamp AND created_at < $4::timestamptz::timestamp
+		GROUP BY day
+		ORDER BY day ASC
+	`
+
+	rows, err := r.db.DB.QueryContext(ctx, query, creatorID, calendarDTO.Location.String(), start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	days := []*model.DiaryCalendarDay{}
+	for rows.Next() {
+		day := &model.DiaryCalendarDay{}
+		if err := rows.Scan(&day.Date, &day.Count, &day.FirstTitle, &day.HasImages); err != nil {
+			return nil, err
+		}
+		days = append(days, day)
+	}
+
+	return days, rows.Err()
+}
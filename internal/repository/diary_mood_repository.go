@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+)
+
+// ListMoods 함수는 일기에 지정할 수 있는 감정 목록을 표시 순서대로 조회합니다.
+func (r *diaryRepository) ListMoods(ctx context.Context) ([]*model.Mood, error) {
+	rows, err := r.db.DB.QueryContext(ctx, "SELECT code, label FROM moods ORDER BY sort_order ASC, code ASC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	moods := []*model.Mood{}
+	for rows.Next() {
+		mood := &model.Mood{}
+		if err := rows.Scan(&mood.Code, &mood.Label); err != nil {
+			return nil, err
+		}
+		moods = append(moods, mood)
+	}
+
+	return moods, rows.Err()
+}
+
+// GetMoodStats 함수는 감정을 지정한 일기를 사용자 시간대 기준 기간(일, 주, 월)과 감정별로 집계합니다.
+// 기간은 오래된 순, 같은 기간의 감정은 일기 수가 많은 순으로 반환하며 일기가 없는 기간은 포함하지 않습니다.
+func (r *diaryRepository) GetMoodStats(ctx context.Context, creatorID int64, statsDTO dto.MoodStatsDTO) ([]*model.MoodPeriod, error) {
+	where := " WHERE creator_id = $1 AND is_deleted = FALSE AND mood IS NOT NULL"
+	args := []interface{}{creatorID, statsDTO.Interval, statsDTO.Location.String()}
+
+	if statsDTO.FromTime != nil {
+		args = append(args, *statsDTO.FromTime)
+		where += " AND created_at >= $" + strconv.Itoa(len(args)) + "::timestamptz::timestamp"
+	}
+	if statsDTO.ToTime != nil {
+		args = append(args, *statsDTO.ToTime)
+		where += " AND created_at < $" + strconv.Itoa(len(args)) + "::timestamptz::timestamp"
+	}
+
+	query := `
+		SELECT TO_CHAR(DATE_TRUNC($2::text, created_at::timestamptz AT TIME ZONE $3), 'YYYY-MM-DD') AS period,
+		       mood,
+		       COUNT(*),
+		       COALESCE(SUM(mood_intensity), 0),
+		       COUNT(mood_intensity)
+		FROM diaries` + where + `
+		GROUP BY period, mood
+		ORDER BY period ASC, COUNT(*) DESC, mood ASC
+	`
+
+	rows, err := r.db.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	periods := []*model.MoodPeriod{}
+	var current *model.MoodPeriod
+	for rows.Next() {
+		var period string
+		count := &model.MoodCount{}
+		if err := rows.Scan(&period, &count.Mood, &count.Count, &count.IntensitySum, &count.IntensityCount); err != nil {
+			return nil, err
+		}
+
+		// 기간 순으로 정렬되어 있으므로 기간이 바뀔 때마다 새 항목 추가
+		if current == nil || current.Period != period {
+			current = &model.MoodPeriod{Period: period, Moods: []*model.MoodCount{}}
+			periods = append(periods, current)
+		}
+		current.Total += count.Count
+		current.Moods = append(current.Moods, count)
+	}
+
+	return periods, rows.Err()
+}
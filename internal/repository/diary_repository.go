@@ -5,25 +5,41 @@ import (
 	"database/sql"
 	"errors"
 	"mime/multipart"
-	"net/url"
 
 	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/dto"
 	"github.com/jhphon0730/dairify/internal/model"
 	"github.com/jhphon0730/dairify/pkg/apperror"
 	"github.com/jhphon0730/dairify/pkg/utils"
+	"github.com/lib/pq"
 )
 
 // DiaryRepository는 일기 관련 데이터베이스 작업을 처리하는 인터페이스입니다.
 type DiaryRepository interface {
 	GetDiaryByID(ctx context.Context, diary *model.Diary) error
-	GetDiariesByCreatorID(ctx context.Context, creatorID int64, params url.Values) ([]model.Diary, error)
+	GetDiariesByCreatorID(ctx context.Context, creatorID int64, filter dto.DiaryListFilterDTO, page dto.CursorPageDTO) ([]model.Diary, error)
+	CountDiariesByCreatorID(ctx context.Context, creatorID int64, filter dto.DiaryListFilterDTO) (int64, error)
+	SearchDiaries(ctx context.Context, creatorID int64, searchDTO dto.SearchDiariesDTO) ([]*model.DiarySearchResult, int64, error)
+	GetDiaryCalendar(ctx context.Context, creatorID int64, calendarDTO dto.DiaryCalendarDTO) ([]*model.DiaryCalendarDay, error)
+	ListMoods(ctx context.Context) ([]*model.Mood, error)
+	GetMoodStats(ctx context.Context, creatorID int64, statsDTO dto.MoodStatsDTO) ([]*model.MoodPeriod, error)
 	CreateDiary(ctx context.Context, diary *model.Diary) error
 	DeleteDiary(ctx context.Context, diaryID int64, creatorID int64) error
-	UpdateDiary(ctx context.Context, diary *model.Diary) error
+	UpdateDiary(ctx context.Context, diary *model.Diary, restoredFrom *int) error
+	GetDiaryRevisions(ctx context.Context, diaryID int64, limit int) ([]*model.DiaryRevision, error)
+	GetDiaryRevision(ctx context.Context, diaryID int64, revisionNumber int) (*model.DiaryRevision, error)
+	GetTrashedDiaries(ctx context.Context, creatorID int64, page dto.CursorPageDTO) ([]model.Diary, error)
+	CountTrashedDiaries(ctx context.Context, creatorID int64) (int64, error)
+	RestoreDiary(ctx context.Context, diaryID int64, creatorID int64) error
+	PurgeDiary(ctx context.Context, diaryID int64, creatorID int64) ([]string, error)
+	PurgeExpiredDiaries(ctx context.Context, retentionSeconds int64) (int, []string, error)
 	UploadDiaryImage(ctx context.Context, file []*multipart.FileHeader, diaryID int64) ([]*model.DiaryImage, error)
 	GetImagesByDiaryID(ctx context.Context, diaryID int64) ([]*model.DiaryImage, error)
 }
 
+// DIARY_SELECT_COLUMNS는 scanDiary로 읽는 일기 조회 컬럼 목록입니다.
+const DIARY_SELECT_COLUMNS = "id, title, content, creator_id, category_id, mood, mood_intensity, version, created_at, updated_at, is_deleted, deleted_at"
+
 // diaryRepository 구조체는 DiaryRepository 인터페이스를 구현합니다.
 type diaryRepository struct {
 	db *database.DB
@@ -36,37 +52,77 @@ func NewDiaryRepository(db *database.DB) DiaryRepository {
 	}
 }
 
-// GetDiariesByCreatorID 함수는 주어진 생성자 ID로 일기 목록을 조회합니다.
-func (r *diaryRepository) GetDiariesByCreatorID(ctx context.Context, creatorID int64, params url.Values) ([]model.Diary, error) {
-	var diaries []model.Diary
+// scanDiary 함수는 DIARY_SELECT_COLUMNS 순서로 조회된 행을 diary에 채웁니다.
+func scanDiary(row rowScanner, diary *model.Diary) error {
+	return row.Scan(&diary.ID, &diary.Title, &diary.Content, &diary.CreatorID, &diary.CategoryID, &diary.Mood, &diary.MoodIntensity, &diary.Version, &diary.CreatedAt, &diary.UpdatedAt, &diary.IsDeleted, &diary.DeletedAt)
+}
 
+// buildDiaryListFilter 함수는 일기 목록의 검색 조건과 인자를 만듭니다. 조건은 " AND ..." 형태로 이어 붙일 수 있습니다.
+func buildDiaryListFilter(creatorID int64, filter dto.DiaryListFilterDTO) (string, []interface{}) {
 	// 소프트 삭제된 레코드는 제외
-	query := "SELECT id, title, content, creator_id, category_id, created_at, updated_at, is_deleted, deleted_at FROM diaries WHERE creator_id = $1 AND is_deleted = FALSE"
+	where := " WHERE creator_id = $1 AND is_deleted = FALSE"
 	args := []interface{}{creatorID}
-	argIdx := 2 // $2부터 시작
 
 	// 카테고리 필터링 추가
-	if v := params.Get("category_id"); v != "" {
-		query += " AND category_id = $" + utils.InterfaceToString(argIdx)
-		args = append(args, v)
-		argIdx++
+	if filter.CategoryID != nil {
+		args = append(args, *filter.CategoryID)
+		where += " AND category_id = $" + utils.InterfaceToString(len(args))
 	}
 
 	// 제목 필터링 추가 (LIKE 검색)
-	if v := params.Get("title"); v != "" {
+	if filter.Title != "" {
 		// 부분 일치 검색을 위해 %%를 양쪽에 붙임
-		query += " AND title LIKE $" + utils.InterfaceToString(argIdx)
-		args = append(args, "%"+v+"%")
-		argIdx++
+		args = append(args, "%"+filter.Title+"%")
+		where += " AND title LIKE $" + utils.InterfaceToString(len(args))
+	}
+
+	// 감정 필터링 추가 (여러 감정 중 하나와 일치)
+	if len(filter.Moods) > 0 {
+		args = append(args, pq.Array(filter.Moods))
+		where += " AND mood = ANY($" + utils.InterfaceToString(len(args)) + ")"
+	}
+
+	// 태그 필터링 추가 (all: 모든 태그 지정, any: 하나 이상 지정)
+	if len(filter.Tags) > 0 {
+		args = append(args, pq.Array(filter.Tags))
+		tagMatch := "FROM diary_tags JOIN tags ON tags.id = diary_tags.tag_id WHERE diary_tags.diary_id = diaries.id AND LOWER(tags.name) = ANY($" + utils.InterfaceToString(len(args)) + ")"
+		if filter.TagMode == dto.TAG_FILTER_MODE_ANY {
+			where += " AND EXISTS (SELECT 1 " + tagMatch + ")"
+		} else {
+			// 사용자별 태그 이름은 대소문자 구분 없이 고유하므로 일치한 태그 수로 모두 지정되었는지 확인
+			args = append(args, len(filter.Tags))
+			where += " AND (SELECT COUNT(*) " + tagMatch + ") = $" + utils.InterfaceToString(len(args))
+		}
+	}
+
+	// 작성 날짜 필터링 추가 (사용자 시간대의 0시를 DB 세션 시간대 기준 시각으로 변환하여 비교)
+	if filter.FromTime != nil {
+		args = append(args, *filter.FromTime)
+		where += " AND created_at >= $" + utils.InterfaceToString(len(args)) + "::timestamptz::timestamp"
+	}
+	if filter.ToTime != nil {
+		args = append(args, *filter.ToTime)
+		where += " AND created_at < $" + utils.InterfaceToString(len(args)) + "::timestamptz::timestamp"
 	}
 
-	// 정렬 조건 추가
-	query += " ORDER BY created_at DESC"
+	// 검색식 조건 추가
+	return appendSearchQueryFilter(where, args, filter.Search)
+}
+
+// GetDiariesByCreatorID 함수는 주어진 생성자 ID로 일기 목록을 (created_at, id) 커서 기준으로 한 페이지 조회합니다.
+// 다음 페이지 존재 여부를 판단할 수 있도록 page.Limit보다 한 건 더 조회하며, 이전 페이지 방향이면 오래된 순으로 반환합니다.
+func (r *diaryRepository) GetDiariesByCreatorID(ctx context.Context, creatorID int64, filter dto.DiaryListFilterDTO, page dto.CursorPageDTO) ([]model.Diary, error) {
+	diaries := []model.Diary{}
+
+	where, args := buildDiaryListFilter(creatorID, filter)
+	keyset, keysetArgs := buildKeysetClause(page, len(args)+1)
+	query := "SELECT " + DIARY_SELECT_COLUMNS + " FROM diaries" + where + keyset
+	args = append(args, keysetArgs...)
 
 	rows, err := r.db.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, nil
+			return diaries, nil
 		}
 		return nil, err
 	}
@@ -74,36 +130,84 @@ func (r *diaryRepository) GetDiariesByCreatorID(ctx context.Context, creatorID i
 
 	for rows.Next() {
 		var diary model.Diary
-		if err := rows.Scan(&diary.ID, &diary.Title, &diary.Content, &diary.CreatorID, &diary.CategoryID, &diary.CreatedAt, &diary.UpdatedAt, &diary.IsDeleted, &diary.DeletedAt); err != nil {
+		if err := scanDiary(rows, &diary); err != nil {
 			return nil, err
 		}
 		diaries = append(diaries, diary)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 페이지에 포함된 일기의 태그를 한 번에 조회
+	if err := r.fillDiaryTags(ctx, diaries); err != nil {
+		return nil, err
+	}
 
 	// 결과 반환
 	return diaries, nil
 }
 
-// CreateDiary 함수는 새로운 일기를 생성합니다.
+// CountDiariesByCreatorID 함수는 목록 조회와 같은 조건의 일기 전체 개수를 조회합니다.
+func (r *diaryRepository) CountDiariesByCreatorID(ctx context.Context, creatorID int64, filter dto.DiaryListFilterDTO) (int64, error) {
+	where, args := buildDiaryListFilter(creatorID, filter)
+
+	var total int64
+	if err := r.db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM diaries"+where, args...).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// CreateDiary 함수는 새로운 일기를 생성하고 태그를 지정합니다. 아직 없는 태그는 함께 생성합니다.
+// 작성한 제목과 내용은 첫 번째 수정 기록으로 함께 저장합니다.
 func (r *diaryRepository) CreateDiary(ctx context.Context, diary *model.Diary) error {
-	query := "INSERT INTO diaries (title, content, creator_id, category_id) VALUES ($1, $2, $3, $4) RETURNING id"
-	err := r.db.DB.QueryRowContext(ctx, query, diary.Title, diary.Content, diary.CreatorID, diary.CategoryID).Scan(&diary.ID)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return apperror.ErrDiaryCreateInternal
 	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := "INSERT INTO diaries (title, content, creator_id, category_id, mood, mood_intensity) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, version"
+	err = tx.QueryRowContext(ctx, query, diary.Title, diary.Content, diary.CreatorID, diary.CategoryID, diary.Mood, diary.MoodIntensity).Scan(&diary.ID, &diary.Version)
+	if err != nil {
+		return apperror.ErrDiaryCreateInternal
+	}
+	if err := replaceDiaryTags(ctx, tx, diary.CreatorID, diary.ID, diary.Tags); err != nil {
+		return apperror.ErrDiaryCreateInternal
+	}
+	if err := insertDiaryRevision(ctx, tx, diary, nil); err != nil {
+		return apperror.ErrDiaryCreateInternal
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperror.ErrDiaryCreateInternal
+	}
 	return nil
 }
 
 // GetDiaryByID 함수는 ID로 일기를 조회합니다.
 func (r *diaryRepository) GetDiaryByID(ctx context.Context, diary *model.Diary) error {
-	query := "SELECT id, title, content, creator_id, category_id, created_at, updated_at, is_deleted, deleted_at FROM diaries WHERE id = $1 AND is_deleted = FALSE"
-	if err := r.db.DB.QueryRowContext(ctx, query, diary.ID).Scan(&diary.ID, &diary.Title, &diary.Content, &diary.CreatorID, &diary.CategoryID, &diary.CreatedAt, &diary.UpdatedAt, &diary.IsDeleted, &diary.DeletedAt); err != nil {
+	query := "SELECT " + DIARY_SELECT_COLUMNS + " FROM diaries WHERE id = $1 AND is_deleted = FALSE"
+	if err := scanDiary(r.db.DB.QueryRowContext(ctx, query, diary.ID), diary); err != nil {
 		// 조회 실패 시에는 id가 이상한 값이거나, 해당 일기가 존재하지 않는 경우
 		if errors.Is(err, sql.ErrNoRows) {
 			return apperror.ErrDiaryNotFound
 		}
 		return apperror.ErrDiaryGetInternal
 	}
+
+	tagNames, err := r.getTagNamesByDiaryIDs(ctx, []int64{diary.ID})
+	if err != nil {
+		return apperror.ErrDiaryGetInternal
+	}
+	diary.Tags = tagNames[diary.ID]
+	if diary.Tags == nil {
+		diary.Tags = []string{}
+	}
 	return nil
 }
 
@@ -125,19 +229,50 @@ func (r *diaryRepository) DeleteDiary(ctx context.Context, diaryID int64, creato
 	return nil
 }
 
-// UpdateDiary 함수는 일기를 업데이트합니다.
-func (r *diaryRepository) UpdateDiary(ctx context.Context, diary *model.Diary) error {
-	query := "UPDATE diaries SET title = $1, content = $2, updated_at = CURRENT_TIMESTAMP WHERE id = $3 AND is_deleted = FALSE"
-	res, err := r.db.DB.ExecContext(ctx, query, diary.Title, diary.Content, diary.ID)
+// UpdateDiary 함수는 일기의 제목, 내용, 카테고리, 감정을 업데이트하고 태그를 diary.Tags로 교체합니다. 아직 없는 태그는 함께 생성합니다.
+// 수정한 제목과 내용은 같은 트랜잭션에서 새 수정 기록으로 저장하며, restoredFrom은 복원한 수정 기록 번호입니다.
+// diary.Version이 0보다 크면 현재 버전이 같을 때만 수정하고, 다르면 apperror.ErrDiaryVersionConflict를 반환합니다.
+// 수정에 성공하면 diary.Version과 diary.UpdatedAt을 새 값으로 갱신합니다.
+func (r *diaryRepository) UpdateDiary(ctx context.Context, diary *model.Diary, restoredFrom *int) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return apperror.ErrDiaryUpdateInternal
 	}
-	rows, err := res.RowsAffected()
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// 태그를 생성할 사용자를 알 수 있도록 작성자 ID를 함께 반환
+	query := `
+		UPDATE diaries
+		SET title = $1, content = $2, category_id = $3, mood = $4, mood_intensity = $5, version = version + 1, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $6 AND is_deleted = FALSE AND ($7 = 0 OR version = $7)
+		RETURNING creator_id, version, updated_at
+	`
+	err = tx.QueryRowContext(ctx, query, diary.Title, diary.Content, diary.CategoryID, diary.Mood, diary.MoodIntensity, diary.ID, diary.Version).Scan(&diary.CreatorID, &diary.Version, &diary.UpdatedAt)
 	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return apperror.ErrDiaryUpdateInternal
+		}
+		// 수정된 행이 없으면 일기가 없는 것인지 버전이 달라진 것인지 구분
+		var exists bool
+		if err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM diaries WHERE id = $1 AND is_deleted = FALSE)", diary.ID).Scan(&exists); err != nil {
+			return apperror.ErrDiaryUpdateInternal
+		}
+		if exists {
+			return apperror.ErrDiaryVersionConflict
+		}
+		return apperror.ErrDiaryNotFound
+	}
+	if err := replaceDiaryTags(ctx, tx, diary.CreatorID, diary.ID, diary.Tags); err != nil {
 		return apperror.ErrDiaryUpdateInternal
 	}
-	if rows == 0 {
-		return apperror.ErrDiaryNotFound
+	if err := insertDiaryRevision(ctx, tx, diary, restoredFrom); err != nil {
+		return apperror.ErrDiaryUpdateInternal
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperror.ErrDiaryUpdateInternal
 	}
 	return nil
 }
@@ -172,6 +307,12 @@ func (r *diaryRepository) UploadDiaryImage(ctx context.Context, files []*multipa
 		}
 	}
 
+	// 이미지 목록도 일기 응답에 포함되므로 ETag가 바뀌도록 버전 증가
+	if _, err := tx.ExecContext(ctx, "UPDATE diaries SET version = version + 1 WHERE id = $1", diaryID); err != nil {
+		utils.RemoveDiaryImages(diaryImages)
+		return nil, apperror.ErrDiaryImageUploadInternal
+	}
+
 	return diaryImages, tx.Commit()
 }
 
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// insertDiaryRevision 함수는 트랜잭션 안에서 일기의 현재 제목과 내용을 다음 번호의 수정 기록으로 저장합니다.
+// 최신 수정 기록과 제목, 내용이 같으면(태그나 감정만 바뀐 경우) 저장하지 않습니다.
+func insertDiaryRevision(ctx context.Context, tx *sql.Tx, diary *model.Diary, restoredFrom *int) error {
+	var latestTitle, latestContent string
+	query := "SELECT title, content FROM diary_revisions WHERE diary_id = $1 ORDER BY revision DESC LIMIT 1"
+	err := tx.QueryRowContext(ctx, query, diary.ID).Scan(&latestTitle, &latestContent)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if err == nil && latestTitle == diary.Title && latestContent == diary.Content {
+		return nil
+	}
+
+	insertRevision := `
+		INSERT INTO diary_revisions (diary_id, revision, title, content, restored_from)
+		SELECT $1::integer, COALESCE(MAX(revision), 0) + 1, $2::text, $3::text, $4::integer
+		FROM diary_revisions
+		WHERE diary_id = $1
+	`
+	if _, err := tx.ExecContext(ctx, insertRevision, diary.ID, diary.Title, diary.Content, restoredFrom); err != nil {
+		return err
+	}
+
+	// 사용자의 보관 개수 설정을 넘는 오래된 수정 기록 삭제
+	pruneRevisions := `
+		DELETE FROM diary_revisions
+		WHERE diary_id = $1
+		  AND revision <= (SELECT MAX(revision) FROM diary_revisions WHERE diary_id = $1) - (SELECT diary_revision_limit FROM users WHERE id = $2)
+	`
+	_, err = tx.ExecContext(ctx, pruneRevisions, diary.ID, diary.CreatorID)
+	return err
+}
+
+// pruneUserDiaryRevisions 함수는 트랜잭션 안에서 사용자의 모든 일기에 대해 보관 개수 설정을 넘는 오래된 수정 기록을 삭제합니다.
+// 보관 개수를 줄였을 때 다음 수정 전까지 초과분이 번호로 조회되지 않도록 설정 변경과 함께 호출합니다.
+func pruneUserDiaryRevisions(ctx context.Context, tx *sql.Tx, userID int64) error {
+	query := `
+		DELETE FROM diary_revisions
+		USING (
+			SELECT diary_revisions.diary_id, MAX(diary_revisions.revision) AS latest
+			FROM diary_revisions
+			JOIN diaries ON diaries.id = diary_revisions.diary_id
+			WHERE diaries.creator_id = $1
+			GROUP BY diary_revisions.diary_id
+		) latest
+		WHERE diary_revisions.diary_id = latest.diary_id
+		  AND diary_revisions.revision <= latest.latest - (SELECT diary_revision_limit FROM users WHERE id = $1)
+	`
+	_, err := tx.ExecContext(ctx, query, userID)
+	return err
+}
+
+// GetDiaryRevisions 함수는 일기의 수정 기록을 최신순으로 최대 limit개 조회합니다. 내용은 포함하지 않습니다.
+func (r *diaryRepository) GetDiaryRevisions(ctx context.Context, diaryID int64, limit int) ([]*model.DiaryRevision, error) {
+	query := `
+		SELECT id, diary_id, revision, title, restored_from, created_at
+		FROM diary_revisions
+		WHERE diary_id = $1
+		ORDER BY revision DESC
+		LIMIT $2
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, diaryID, limit)
+	if err != nil {
+		return nil, apperror.ErrDiaryRevisionInternal
+	}
+	defer rows.Close()
+
+	revisions := []*model.DiaryRevision{}
+	for rows.Next() {
+		revision := &model.DiaryRevision{}
+		if err := rows.Scan(&revision.ID, &revision.DiaryID, &revision.Revision, &revision.Title, &revision.RestoredFrom, &revision.CreatedAt); err != nil {
+			return nil, apperror.ErrDiaryRevisionInternal
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.ErrDiaryRevisionInternal
+	}
+	return revisions, nil
+}
+
+// GetDiaryRevision 함수는 일기의 수정 기록 하나를 내용과 함께 조회합니다.
+func (r *diaryRepository) GetDiaryRevision(ctx context.Context, diaryID int64, revisionNumber int) (*model.DiaryRevision, error) {
+	query := `
+		SELECT id, diary_id, revision, title, content, restored_from, created_at,
+		       revision = (SELECT MAX(revision) FROM diary_revisions latest WHERE latest.diary_id = diary_revisions.diary_id)
+		FROM diary_revisions
+		WHERE diary_id = $1 AND revision = $2
+	`
+	revision := &model.DiaryRevision{}
+	err := r.db.DB.QueryRowContext(ctx, query, diaryID, revisionNumber).Scan(&revision.ID, &revision.DiaryID, &revision.Revision, &revision.Title, &revision.Content, &revision.RestoredFrom, &revision.CreatedAt, &revision.IsCurrent)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrDiaryRevisionNotFound
+		}
+		return nil, apperror.ErrDiaryRevisionInternal
+	}
+	return revision, nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/searchquery"
+)
+
+// searchTermCondition 함수는 검색식의 검색어 하나를 SQL 조건으로 변환하고 필요한 인자를 추가합니다.
+// 일반 검색어와 구문은 pg_trgm GIN 인덱스로 부분 일치(ILIKE) 검색합니다.
+// 날짜 연산자는 사용자 시간대의 0시를 DB 세션 시간대 기준 시각으로 바꿔 created_at과 비교합니다.
+func searchTermCondition(query *searchquery.Query, term searchquery.Term, args []interface{}) (string, []interface{}) {
+	switch term.Operator {
+	case searchquery.OPERATOR_CATEGORY:
+		args = append(args, term.Value)
+		return "EXISTS (SELECT 1 FROM categories WHERE categories.id = diaries.category_id AND categories.creator_id = diaries.creator_id AND LOWER(categories.name) = LOWER($" + strconv.Itoa(len(args)) + "))", args
+	case searchquery.OPERATOR_BEFORE:
+		args = append(args, query.DayStart(term.Date))
+		return "created_at < $" + strconv.Itoa(len(args)) + "::timestamptz::timestamp", args
+	case searchquery.OPERATOR_AFTER:
+		args = append(args, query.DayStart(term.Date))
+		return "created_at >= $" + strconv.Itoa(len(args)) + "::timestamptz::timestamp", args
+	case searchquery.OPERATOR_HAS:
+		switch term.Value {
+		case searchquery.HAS_IMAGE:
+			return "EXISTS (SELECT 1 FROM images WHERE images.diary_id = diaries.id)", args
+		case searchquery.HAS_MOOD:
+			return "mood IS NOT NULL", args
+		}
+		return "category_id IS NOT NULL", args
+	case searchquery.OPERATOR_TAG:
+		args = append(args, term.Value)
+		return "EXISTS (SELECT 1 FROM diary_tags JOIN tags ON tags.id = diary_tags.tag_id WHERE diary_tags.diary_id = diaries.id AND LOWER(tags.name) = LOWER($" + strconv.Itoa(len(args)) + "))", args
+	case searchquery.OPERATOR_MOOD:
+		// 감정이 없는 일기도 제외 검색(-mood:)에 포함되도록 NULL 비교 결과를 FALSE로 처리
+		args = append(args, term.Value)
+		return "COALESCE(mood = $" + strconv.Itoa(len(args)) + ", FALSE)", args
+	}
+
+	args = append(args, "%"+escapeLikePattern(term.Value)+"%")
+	placeholder := "$" + strconv.Itoa(len(args))
+	return "(title ILIKE " + placeholder + " OR content ILIKE " + placeholder + ")", args
+}
+
+// appendSearchQueryFilter 함수는 검색식의 모든 검색어를 AND 조건으로 where 절에 이어 붙입니다.
+// 제외 검색어(-)는 NOT으로 감싸며, 검색식이 없으면 조건을 추가하지 않습니다.
+func appendSearchQueryFilter(where string, args []interface{}, query *searchquery.Query) (string, []interface{}) {
+	if query.IsEmpty() {
+		return where, args
+	}
+
+	for _, term := range query.Terms {
+		condition, nextArgs := searchTermCondition(query, term, args)
+		args = nextArgs
+		if term.Negated {
+			condition = "NOT " + condition
+		}
+		where += " AND " + condition
+	}
+
+	return where, args
+}
+
+// buildDiarySearchFilter 함수는 일기 검색 조건과 인자를 만듭니다.
+// 형태소 분석 없이 글자 단위로 비교하므로 띄어쓰기와 조사가 붙는 한국어도 검색됩니다.
+func buildDiarySearchFilter(creatorID int64, searchDTO dto.SearchDiariesDTO) (string, []interface{}) {
+	where := " WHERE creator_id = $1 AND is_deleted = FALSE"
+	args := []interface{}{creatorID}
+
+	if searchDTO.CategoryID != nil {
+		args = append(args, *searchDTO.CategoryID)
+		where += " AND category_id = $" + strconv.Itoa(len(args))
+	}
+
+	return appendSearchQueryFilter(where, args, searchDTO.Search)
+}
+
+// buildDiarySearchScore 함수는 관련도 점수 식과 인자를 만듭니다.
+// 제목에 포함된 검색어마다 가산점을 주어 제목 일치를 본문 일치보다 우선하고, 일반 검색어 전체에 대한 trigram 단어 유사도를 더합니다.
+func buildDiarySearchScore(terms []string, args []interface{}) (string, []interface{}) {
+	args = append(args, strings.Join(terms, " "))
+	placeholder := "$" + strconv.Itoa(len(args))
+	score := "word_similarity(" + placeholder + "::text, title) + word_similarity(" + placeholder + "::text, content)"
+
+	for _, term := range terms {
+		args = append(args, "%"+escapeLikePattern(term)+"%")
+		score += " + CASE WHEN title ILIKE $" + strconv.Itoa(len(args)) + " THEN 1 ELSE 0 END"
+	}
+
+	return score, args
+}
+
+// SearchDiaries 함수는 검색어가 포함된 일기를 관련도 순으로 조회하고 전체 개수를 함께 반환합니다.
+func (r *diaryRepository) SearchDiaries(ctx context.Context, creatorID int64, searchDTO dto.SearchDiariesDTO) ([]*model.DiarySearchResult, int64, error) {
+	where, args := buildDiarySearchFilter(creatorID, searchDTO)
+
+	var total int64
+	if err := r.db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM diaries"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	score, args := buildDiarySearchScore(searchDTO.Search.TextTerms(), args)
+	limitPlaceholder := "$" + strconv.Itoa(len(args)+1)
+	offsetPlaceholder := "$" + strconv.Itoa(len(args)+2)
+	query := `
+		SELECT id, category_id, title, content, mood, mood_intensity, created_at, updated_at, ` + score + ` AS score
+		FROM diaries` + where + `
+		ORDER BY score DESC, created_at DESC, id DESC
+		LIMIT ` + limitPlaceholder + ` OFFSET ` + offsetPlaceholder
+
+	args = append(args, searchDTO.Limit, searchDTO.Offset())
+	rows, err := r.db.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	results := []*model.DiarySearchResult{}
+	for rows.Next() {
+		result := &model.DiarySearchResult{}
+		if err := rows.Scan(&result.ID, &result.CategoryID, &result.Title, &result.Content, &result.Mood, &result.MoodIntensity, &result.CreatedAt, &result.UpdatedAt, &result.Score); err != nil {
+			return nil, 0, err
+		}
+		results = append(results, result)
+	}
+
+	return results, total, rows.Err()
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/lib/pq"
+)
+
+// replaceDiaryTags 함수는 트랜잭션 안에서 일기의 태그를 주어진 이름들로 교체합니다.
+// 아직 없는 태그는 새로 생성하며, 이미 있는 태그는 대소문자 구분 없이 같은 이름의 태그를 사용합니다.
+func replaceDiaryTags(ctx context.Context, tx *sql.Tx, creatorID int64, diaryID int64, names []string) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM diary_tags WHERE diary_id = $1", diaryID); err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	insertTags := `
+		INSERT INTO tags (creator_id, name)
+		SELECT $1::integer, UNNEST($2::text[])
+		ON CONFLICT (creator_id, (LOWER(name))) DO NOTHING
+	`
+	if _, err := tx.ExecContext(ctx, insertTags, creatorID, pq.Array(names)); err != nil {
+		return err
+	}
+
+	lowerNames := make([]string, len(names))
+	for i, name := range names {
+		lowerNames[i] = strings.ToLower(name)
+	}
+	linkTags := `
+		INSERT INTO diary_tags (diary_id, tag_id)
+		SELECT $1::integer, id FROM tags WHERE creator_id = $2 AND LOWER(name) = ANY($3::text[])
+	`
+	_, err := tx.ExecContext(ctx, linkTags, diaryID, creatorID, pq.Array(lowerNames))
+	return err
+}
+
+// getTagNamesByDiaryIDs 함수는 일기별로 지정된 태그 이름을 이름순으로 조회합니다.
+func (r *diaryRepository) getTagNamesByDiaryIDs(ctx context.Context, diaryIDs []int64) (map[int64][]string, error) {
+	tagNames := map[int64][]string{}
+	if len(diaryIDs) == 0 {
+		return tagNames, nil
+	}
+
+	query := `
+		SELECT diary_tags.diary_id, tags.name
+		FROM diary_tags
+		JOIN tags ON tags.id = diary_tags.tag_id
+		WHERE diary_tags.diary_id = ANY($1)
+		ORDER BY LOWER(tags.name) ASC
+	`
+	rows, err := r.db.DB.QueryContext(ctx, query, pq.Array(diaryIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var diaryID int64
+		var name string
+		if err := rows.Scan(&diaryID, &name); err != nil {
+			return nil, err
+		}
+		tagNames[diaryID] = append(tagNames[diaryID], name)
+	}
+
+	return tagNames, rows.Err()
+}
+
+// fillDiaryTags 함수는 일기 목록의 각 일기에 태그 이름을 채웁니다. 태그가 없는 일기는 빈 목록을 가집니다.
+func (r *diaryRepository) fillDiaryTags(ctx context.Context, diaries []model.Diary) error {
+	diaryIDs := make([]int64, len(diaries))
+	for i, diary := range diaries {
+		diaryIDs[i] = diary.ID
+	}
+
+	tagNames, err := r.getTagNamesByDiaryIDs(ctx, diaryIDs)
+	if err != nil {
+		return err
+	}
+	for i := range diaries {
+		diaries[i].Tags = tagNames[diaries[i].ID]
+		if diaries[i].Tags == nil {
+			diaries[i].Tags = []string{}
+		}
+	}
+
+	return nil
+}
+
+// bumpTaggedDiaryVersions 함수는 트랜잭션 안에서 태그가 지정된 일기들의 버전을 올립니다.
+// 태그 이름 변경, 병합, 삭제로 일기의 태그 목록이 바뀌므로 조건부 조회(If-None-Match)가 이전 응답을 재사용하지 않도록 합니다.
+func bumpTaggedDiaryVersions(ctx context.Context, tx *sql.Tx, tagID int64, creatorID int64) error {
+	query := `
+		UPDATE diaries
+		SET version = version + 1
+		WHERE creator_id = $2 AND id IN (SELECT diary_id FROM diary_tags WHERE tag_id = $1)
+	`
+	_, err := tx.ExecContext(ctx, query, tagID, creatorID)
+	return err
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/lib/pq"
+)
+
+// GetTrashedDiaries 함수는 휴지통에 있는 일기 목록을 (deleted_at, id) 커서 기준으로 최근 삭제한 순서대로 한 페이지 조회합니다.
+func (r *diaryRepository) GetTrashedDiaries(ctx context.Context, creatorID int64, page dto.CursorPageDTO) ([]model.Diary, error) {
+	diaries := []model.Diary{}
+
+	keyset, args := buildKeysetClauseOn("deleted_at", page, 2)
+	query := "SELECT " + DIARY_SELECT_COLUMNS + " FROM diaries WHERE creator_id = $1 AND is_deleted = TRUE" + keyset
+	args = append([]interface{}{creatorID}, args...)
+
+	rows, err := r.db.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, apperror.ErrDiaryTrashInternal
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var diary model.Diary
+		if err := scanDiary(rows, &diary); err != nil {
+			return nil, apperror.ErrDiaryTrashInternal
+		}
+		diaries = append(diaries, diary)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.ErrDiaryTrashInternal
+	}
+
+	if err := r.fillDiaryTags(ctx, diaries); err != nil {
+		return nil, apperror.ErrDiaryTrashInternal
+	}
+	return diaries, nil
+}
+
+// CountTrashedDiaries 함수는 휴지통에 있는 일기 전체 개수를 조회합니다.
+func (r *diaryRepository) CountTrashedDiaries(ctx context.Context, creatorID int64) (int64, error) {
+	var total int64
+	query := "SELECT COUNT(*) FROM diaries WHERE creator_id = $1 AND is_deleted = TRUE"
+	if err := r.db.DB.QueryRowContext(ctx, query, creatorID).Scan(&total); err != nil {
+		return 0, apperror.ErrDiaryTrashInternal
+	}
+	return total, nil
+}
+
+// RestoreDiary 함수는 휴지통에 있는 일기를 되살립니다.
+func (r *diaryRepository) RestoreDiary(ctx context.Context, diaryID int64, creatorID int64) error {
+	// 작성자 조건을 추가하여 다른 사용자의 일기 복원 방지
+	query := "UPDATE diaries SET is_deleted = FALSE, deleted_at = NULL WHERE id = $1 AND creator_id = $2 AND is_deleted = TRUE"
+	res, err := r.db.DB.ExecContext(ctx, query, diaryID, creatorID)
+	if err != nil {
+		return apperror.ErrDiaryRestoreInternal
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return apperror.ErrDiaryRestoreInternal
+	}
+	if rows == 0 {
+		return apperror.ErrDiaryNotFoundInTrash
+	}
+	return nil
+}
+
+// PurgeDiary 함수는 휴지통에 있는 일기를 이미지 행과 함께 영구 삭제하고, 디스크에서 지울 이미지 파일 경로를 반환합니다.
+func (r *diaryRepository) PurgeDiary(ctx context.Context, diaryID int64, creatorID int64) ([]string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, apperror.ErrDiaryPurgeInternal
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// 삭제 직전에 다른 요청으로 복원되지 않았는지 행 잠금과 함께 확인
+	var lockedID int64
+	lockQuery := "SELECT id FROM diaries WHERE id = $1 AND creator_id = $2 AND is_deleted = TRUE FOR UPDATE"
+	if err := tx.QueryRowContext(ctx, lockQuery, diaryID, creatorID).Scan(&lockedID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrDiaryNotFoundInTrash
+		}
+		return nil, apperror.ErrDiaryPurgeInternal
+	}
+
+	filePaths, err := purgeDiaries(ctx, tx, []int64{lockedID})
+	if err != nil {
+		return nil, apperror.ErrDiaryPurgeInternal
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, apperror.ErrDiaryPurgeInternal
+	}
+	return filePaths, nil
+}
+
+// PurgeExpiredDiaries 함수는 휴지통에 들어간 지 retentionSeconds초가 지난 일기를 모두 영구 삭제합니다.
+// 삭제한 일기 수와 디스크에서 지울 이미지 파일 경로를 반환합니다.
+func (r *diaryRepository) PurgeExpiredDiaries(ctx context.Context, retentionSeconds int64) (int, []string, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// 사용자가 복원 중인 일기는 건너뛰도록 잠긴 행은 제외
+	query := `
+		SELECT id FROM diaries
+		WHERE is_deleted = TRUE AND deleted_at <= CURRENT_TIMESTAMP - ($1 * INTERVAL '1 second')
+		FOR UPDATE SKIP LOCKED
+	`
+	rows, err := tx.QueryContext(ctx, query, retentionSeconds)
+	if err != nil {
+		return 0, nil, err
+	}
+	var diaryIDs []int64
+	for rows.Next() {
+		var diaryID int64
+		if err := rows.Scan(&diaryID); err != nil {
+			rows.Close()
+			return 0, nil, err
+		}
+		diaryIDs = append(diaryIDs, diaryID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, nil, err
+	}
+	if len(diaryIDs) == 0 {
+		return 0, nil, nil
+	}
+
+	filePaths, err := purgeDiaries(ctx, tx, diaryIDs)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, nil, err
+	}
+	return len(diaryIDs), filePaths, nil
+}
+
+// purgeDiaries 함수는 트랜잭션 안에서 일기와 이미지 행을 삭제하고 삭제한 이미지의 파일 경로를 반환합니다.
+// 태그 연결과 수정 기록은 ON DELETE CASCADE로 함께 삭제됩니다.
+func purgeDiaries(ctx context.Context, tx *sql.Tx, diaryIDs []int64) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "DELETE FROM images WHERE diary_id = ANY($1) RETURNING file_path", pq.Array(diaryIDs))
+	if err != nil {
+		return nil, err
+	}
+	var filePaths []string
+	for rows.Next() {
+		var filePath string
+		if err := rows.Scan(&filePath); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		filePaths = append(filePaths, filePath)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM diaries WHERE id = ANY($1)", pq.Array(diaryIDs)); err != nil {
+		return nil, err
+	}
+	return filePaths, nil
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// MFARepository 인터페이스는 2단계 인증 관련 데이터베이스 작업을 정의합니다.
+type MFARepository interface {
+	SetTOTPSecret(ctx context.Context, userID int64, secret string) error
+	EnableTOTP(ctx context.Context, userID int64, recoveryCodeHashes []string) error
+	DisableTOTP(ctx context.Context, userID int64) error
+	ReplaceRecoveryCodes(ctx context.Context, userID int64, recoveryCodeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error
+	CountRemainingRecoveryCodes(ctx context.Context, userID int64) (int, error)
+}
+
+// mfaRepository 구조체는 MFARepository 인터페이스를 구현합니다.
+type mfaRepository struct {
+	db *database.DB
+}
+
+// NewMFARepository 함수는 MFARepository 인터페이스의 구현체를 반환합니다.
+func NewMFARepository(db *database.DB) MFARepository {
+	return &mfaRepository{db: db}
+}
+
+// SetTOTPSecret 함수는 등록 확인 전의 TOTP 비밀 키를 저장합니다. 이미 활성화된 경우 덮어쓰지 않습니다.
+func (r *mfaRepository) SetTOTPSecret(ctx context.Context, userID int64, secret string) error {
+	query := `
+		UPDATE users
+		SET totp_secret = $2
+		WHERE id = $1 AND totp_enabled_at IS NULL
+	`
+
+	result, err := r.db.DB.ExecContext(ctx, query, userID, secret)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrMFAAlreadyEnabled
+	}
+
+	return nil
+}
+
+// EnableTOTP 함수는 TOTP를 활성화하고 복구 코드를 새로 저장합니다.
+func (r *mfaRepository) EnableTOTP(ctx context.Context, userID int64, recoveryCodeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := `
+		UPDATE users
+		SET totp_enabled_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND totp_secret IS NOT NULL AND totp_enabled_at IS NULL
+	`
+	result, err := tx.ExecContext(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrMFAAlreadyEnabled
+	}
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DisableTOTP 함수는 TOTP 비밀 키와 복구 코드를 모두 삭제합니다.
+func (r *mfaRepository) DisableTOTP(ctx context.Context, userID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "UPDATE users SET totp_secret = NULL, totp_enabled_at = NULL WHERE id = $1", userID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_recovery_codes WHERE user_id = $1", userID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ReplaceRecoveryCodes 함수는 기존 복구 코드를 모두 폐기하고 새 복구 코드로 교체합니다.
+func (r *mfaRepository) ReplaceRecoveryCodes(ctx context.Context, userID int64, recoveryCodeHashes []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if err := replaceRecoveryCodes(ctx, tx, userID, recoveryCodeHashes); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// UseRecoveryCode 함수는 사용하지 않은 복구 코드를 사용 처리합니다. 일치하는 코드가 없으면 에러를 반환합니다.
+func (r *mfaRepository) UseRecoveryCode(ctx context.Context, userID int64, codeHash string) error {
+	query := `
+		UPDATE user_recovery_codes
+		SET used_at = CURRENT_TIMESTAMP
+		WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL
+	`
+
+	result, err := r.db.DB.ExecContext(ctx, query, userID, codeHash)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrMFAInvalidCode
+	}
+
+	return nil
+}
+
+// CountRemainingRecoveryCodes 함수는 사용하지 않은 복구 코드 개수를 반환합니다.
+func (r *mfaRepository) CountRemainingRecoveryCodes(ctx context.Context, userID int64) (int, error) {
+	var count int
+	query := "SELECT COUNT(*) FROM user_recovery_codes WHERE user_id = $1 AND used_at IS NULL"
+	if err := r.db.DB.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// replaceRecoveryCodes 함수는 트랜잭션 안에서 사용자의 복구 코드를 교체합니다.
+func replaceRecoveryCodes(ctx context.Context, tx *sql.Tx, userID int64, recoveryCodeHashes []string) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_recovery_codes WHERE user_id = $1", userID); err != nil {
+		return err
+	}
+
+	for _, codeHash := range recoveryCodeHashes {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO user_recovery_codes (user_id, code_hash) VALUES ($1, $2)", userID, codeHash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"strconv"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+)
+
+// buildKeysetClause 함수는 (created_at, id) 최신 순 목록의 커서 조건과 정렬, LIMIT 절을 만듭니다.
+// argIdx는 다음에 사용할 플레이스홀더 번호이며, 다음 페이지 존재 여부를 알 수 있도록 Limit보다 한 행 더 조회합니다.
+// 이전 페이지 방향은 커서에 가까운 항목부터 가져오기 위해 오래된 순으로 정렬합니다.
+func buildKeysetClause(page dto.CursorPageDTO, argIdx int) (string, []interface{}) {
+	return buildKeysetClauseOn("created_at", page, argIdx)
+}
+
+// buildKeysetClauseOn 함수는 buildKeysetClause와 같지만 created_at 대신 지정한 시각 컬럼을 정렬 기준으로 사용합니다.
+func buildKeysetClauseOn(column string, page dto.CursorPageDTO, argIdx int) (string, []interface{}) {
+	clause := ""
+	args := []interface{}{}
+
+	if page.Position != nil {
+		operator := "<"
+		if page.IsPrev() {
+			operator = ">"
+		}
+		clause += " AND (" + column + ", id) " + operator + " ($" + strconv.Itoa(argIdx) + "::timestamp, $" + strconv.Itoa(argIdx+1) + ")"
+		args = append(args, page.Position.CreatedAt, page.Position.ID)
+		argIdx += 2
+	}
+
+	if page.IsPrev() {
+		clause += " ORDER BY " + column + " ASC, id ASC"
+	} else {
+		clause += " ORDER BY " + column + " DESC, id DESC"
+	}
+	clause += " LIMIT $" + strconv.Itoa(argIdx)
+	args = append(args, page.Limit+1)
+
+	return clause, args
+}
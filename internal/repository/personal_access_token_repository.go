@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// PersonalAccessTokenRepository 인터페이스는 개인 액세스 토큰 관련 데이터베이스 작업을 정의합니다.
+type PersonalAccessTokenRepository interface {
+	CreateToken(ctx context.Context, token *model.PersonalAccessToken) error
+	CountActiveTokens(ctx context.Context, userID int64) (int, error)
+	ListTokens(ctx context.Context, userID int64) ([]*model.PersonalAccessToken, error)
+	RevokeToken(ctx context.Context, userID int64, tokenID int64) error
+	FindActiveTokenByHash(ctx context.Context, tokenHash string) (*model.PersonalAccessToken, error)
+	TouchToken(ctx context.Context, tokenID int64) error
+}
+
+// PAT_SELECT_COLUMNS는 개인 액세스 토큰 조회 시 공통으로 사용하는 컬럼 목록입니다. scanPersonalAccessToken의 순서와 일치해야 합니다.
+const PAT_SELECT_COLUMNS = "id, user_id, name, token_prefix, token_hash, scope, expires_at, last_used_at, created_at"
+
+// PAT_ACTIVE_CONDITION은 폐기되지 않았고 만료되지 않은 토큰을 고르는 조건입니다.
+const PAT_ACTIVE_CONDITION = "revoked_at IS NULL AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)"
+
+// PAT_TOUCH_INTERVAL_SECONDS는 마지막 사용 시각을 갱신하는 최소 간격(초)입니다. 요청마다 쓰기가 발생하지 않도록 합니다.
+const PAT_TOUCH_INTERVAL_SECONDS = 60
+
+// personalAccessTokenRepository 구조체는 PersonalAccessTokenRepository 인터페이스를 구현합니다.
+type personalAccessTokenRepository struct {
+	db *database.DB
+}
+
+// NewPersonalAccessTokenRepository 함수는 PersonalAccessTokenRepository 인터페이스의 구현체를 반환합니다.
+func NewPersonalAccessTokenRepository(db *database.DB) PersonalAccessTokenRepository {
+	return &personalAccessTokenRepository{db: db}
+}
+
+// scanPersonalAccessToken 함수는 PAT_SELECT_COLUMNS 순서로 조회된 행을 model.PersonalAccessToken으로 변환합니다.
+func scanPersonalAccessToken(row rowScanner) (*model.PersonalAccessToken, error) {
+	token := &model.PersonalAccessToken{}
+	if err := row.Scan(&token.ID, &token.UserID, &token.Name, &token.TokenPrefix, &token.TokenHash, &token.Scope, &token.ExpiresAt, &token.LastUsedAt, &token.CreatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperror.ErrPersonalAccessTokenNotFound
+		}
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// CreateToken 함수는 새로운 개인 액세스 토큰을 저장합니다.
+func (r *personalAccessTokenRepository) CreateToken(ctx context.Context, token *model.PersonalAccessToken) error {
+	query := `
+		INSERT INTO personal_access_tokens (user_id, name, token_prefix, token_hash, scope, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+
+	return r.db.DB.QueryRowContext(ctx, query, token.UserID, token.Name, token.TokenPrefix, token.TokenHash, token.Scope, token.ExpiresAt).Scan(&token.ID, &token.CreatedAt)
+}
+
+// CountActiveTokens 함수는 사용자의 사용 가능한 토큰 개수를 반환합니다.
+func (r *personalAccessTokenRepository) CountActiveTokens(ctx context.Context, userID int64) (int, error) {
+	var count int
+	query := "SELECT COUNT(*) FROM personal_access_tokens WHERE user_id = $1 AND " + PAT_ACTIVE_CONDITION
+	if err := r.db.DB.QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// ListTokens 함수는 사용자의 폐기되지 않은 토큰 목록을 최신순으로 조회합니다. 만료된 토큰도 포함합니다.
+func (r *personalAccessTokenRepository) ListTokens(ctx context.Context, userID int64) ([]*model.PersonalAccessToken, error) {
+	query := `
+		SELECT ` + PAT_SELECT_COLUMNS + `
+		FROM personal_access_tokens
+		WHERE user_id = $1 AND revoked_at IS NULL
+		ORDER BY created_at DESC, id DESC
+	`
+
+	rows, err := r.db.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := []*model.PersonalAccessToken{}
+	for rows.Next() {
+		token, err := scanPersonalAccessToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens, rows.Err()
+}
+
+// RevokeToken 함수는 사용자의 토큰을 폐기합니다. 다른 사용자의 토큰이거나 이미 폐기된 경우 에러를 반환합니다.
+func (r *personalAccessTokenRepository) RevokeToken(ctx context.Context, userID int64, tokenID int64) error {
+	query := `
+		UPDATE personal_access_tokens
+		SET revoked_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+	`
+
+	result, err := r.db.DB.ExecContext(ctx, query, tokenID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrPersonalAccessTokenNotFound
+	}
+
+	return nil
+}
+
+// FindActiveTokenByHash 함수는 해시로 사용 가능한 토큰을 조회합니다. 비활성화된 계정의 토큰은 조회되지 않습니다.
+func (r *personalAccessTokenRepository) FindActiveTokenByHash(ctx context.Context, tokenHash string) (*model.PersonalAccessToken, error) {
+	query := `
+		SELECT ` + PAT_SELECT_COLUMNS + `
+		FROM personal_access_tokens
+		WHERE token_hash = $1 AND ` + PAT_ACTIVE_CONDITION + `
+		AND NOT EXISTS (SELECT 1 FROM users WHERE users.id = personal_access_tokens.user_id AND users.disabled_at IS NOT NULL)`
+
+	return scanPersonalAccessToken(r.db.DB.QueryRowContext(ctx, query, tokenHash))
+}
+
+// TouchToken 함수는 토큰의 마지막 사용 시각을 갱신합니다. 최근에 갱신된 경우에는 건너뜁니다.
+func (r *personalAccessTokenRepository) TouchToken(ctx context.Context, tokenID int64) error {
+	query := `
+		UPDATE personal_access_tokens
+		SET last_used_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < CURRENT_TIMESTAMP - ($2 * INTERVAL '1 second'))
+	`
+
+	_, err := r.db.DB.ExecContext(ctx, query, tokenID, PAT_TOUCH_INTERVAL_SECONDS)
+	return err
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+)
+
+// SigninLockoutRepository 인터페이스는 로그인 잠금 기록 관련 데이터베이스 작업을 정의합니다.
+type SigninLockoutRepository interface {
+	CreateLockout(ctx context.Context, lockout *model.SigninLockout) error
+	ListLockouts(ctx context.Context, listDTO dto.AdminListSigninLockoutsDTO) ([]*model.SigninLockout, int64, error)
+}
+
+// SIGNIN_LOCKOUT_SELECT_COLUMNS는 로그인 잠금 기록 조회 시 공통으로 사용하는 컬럼 목록입니다.
+const SIGNIN_LOCKOUT_SELECT_COLUMNS = "id, scope, identifier, user_id, ip, failure_count, locked_until, created_at"
+
+// signinLockoutRepository 구조체는 SigninLockoutRepository 인터페이스를 구현합니다.
+type signinLockoutRepository struct {
+	db *database.DB
+}
+
+// NewSigninLockoutRepository 함수는 SigninLockoutRepository 인터페이스의 구현체를 반환합니다.
+func NewSigninLockoutRepository(db *database.DB) SigninLockoutRepository {
+	return &signinLockoutRepository{db: db}
+}
+
+// CreateLockout 함수는 로그인 잠금 기록을 추가합니다.
+func (r *signinLockoutRepository) CreateLockout(ctx context.Context, lockout *model.SigninLockout) error {
+	query := `
+		INSERT INTO signin_lockouts (scope, identifier, user_id, ip, failure_count, locked_until)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+
+	return r.db.DB.QueryRowContext(ctx, query, lockout.Scope, lockout.Identifier, lockout.UserID, lockout.IP, lockout.FailureCount, lockout.LockedUntil).Scan(&lockout.ID, &lockout.CreatedAt)
+}
+
+// ListLockouts 함수는 조건에 맞는 로그인 잠금 기록을 최신순으로 조회하고 전체 개수를 함께 반환합니다.
+func (r *signinLockoutRepository) ListLockouts(ctx context.Context, listDTO dto.AdminListSigninLockoutsDTO) ([]*model.SigninLockout, int64, error) {
+	conditions := []string{"TRUE"}
+	args := []interface{}{}
+
+	if listDTO.Scope != "" {
+		args = append(args, listDTO.Scope)
+		conditions = append(conditions, "scope = $"+strconv.Itoa(len(args)))
+	}
+	if listDTO.Identifier != "" {
+		args = append(args, listDTO.Identifier)
+		conditions = append(conditions, "LOWER(identifier) = $"+strconv.Itoa(len(args)))
+	}
+	if listDTO.UserID != nil {
+		args = append(args, *listDTO.UserID)
+		conditions = append(conditions, "user_id = $"+strconv.Itoa(len(args)))
+	}
+	if listDTO.Active {
+		conditions = append(conditions, "locked_until > CURRENT_TIMESTAMP")
+	}
+	where := strings.Join(conditions, " AND ")
+
+	var total int64
+	if err := r.db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM signin_lockouts WHERE "+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `
+		SELECT ` + SIGNIN_LOCKOUT_SELECT_COLUMNS + `
+		FROM signin_lockouts
+		WHERE ` + where + `
+		ORDER BY id DESC
+		LIMIT $` + strconv.Itoa(len(args)+1) + ` OFFSET $` + strconv.Itoa(len(args)+2)
+
+	args = append(args, listDTO.Limit, listDTO.Offset())
+	rows, err := r.db.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	lockouts := []*model.SigninLockout{}
+	for rows.Next() {
+		lockout := &model.SigninLockout{}
+		if err := rows.Scan(&lockout.ID, &lockout.Scope, &lockout.Identifier, &lockout.UserID, &lockout.IP, &lockout.FailureCount, &lockout.LockedUntil, &lockout.CreatedAt); err != nil {
+			return nil, 0, err
+		}
+		lockouts = append(lockouts, lockout)
+	}
+
+	return lockouts, total, rows.Err()
+}
@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/lib/pq"
+)
+
+// TagRepository 인터페이스는 태그 관련 데이터베이스 작업을 정의합니다.
+// 태그 생성과 일기 연결은 일기 저장과 같은 트랜잭션에서 DiaryRepository가 처리합니다.
+type TagRepository interface {
+	GetTagsByCreatorID(ctx context.Context, creatorID int64) ([]*model.Tag, error)
+	GetTagByID(ctx context.Context, id int64, creatorID int64) (*model.Tag, error)
+	FindTagNamesByPrefix(ctx context.Context, creatorID int64, prefix string, limit int) ([]string, error)
+	UpdateTagName(ctx context.Context, tag *model.Tag) error
+	MergeTags(ctx context.Context, sourceID int64, targetID int64, creatorID int64) error
+	DeleteTag(ctx context.Context, id int64, creatorID int64) error
+}
+
+// TAG_SELECT_COLUMNS는 tags 테이블의 조회 컬럼과 태그별 일기 수(삭제된 일기 제외)입니다. scanTag와 순서가 같아야 합니다.
+const TAG_SELECT_COLUMNS = `tags.id, tags.name, tags.creator_id, tags.created_at,
+	(SELECT COUNT(*) FROM diary_tags JOIN diaries ON diaries.id = diary_tags.diary_id WHERE diary_tags.tag_id = tags.id AND diaries.is_deleted = FALSE)`
+
+// tagRepository 구조체는 TagRepository 인터페이스를 구현합니다.
+type tagRepository struct {
+	db *database.DB
+}
+
+// NewTagRepository 함수는 TagRepository 인터페이스의 구현체를 반환합니다.
+func NewTagRepository(db *database.DB) TagRepository {
+	return &tagRepository{db: db}
+}
+
+// scanTag 함수는 TAG_SELECT_COLUMNS 순서로 조회된 행을 model.Tag로 변환합니다.
+func scanTag(row rowScanner) (*model.Tag, error) {
+	tag := &model.Tag{}
+	if err := row.Scan(&tag.ID, &tag.Name, &tag.CreatorID, &tag.CreatedAt, &tag.DiaryCount); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrTagNotFound
+		}
+		return nil, err
+	}
+	return tag, nil
+}
+
+// GetTagsByCreatorID 함수는 사용자의 모든 태그를 일기 수가 많은 순, 이름순으로 조회합니다. 일기가 없는 태그도 포함합니다.
+func (r *tagRepository) GetTagsByCreatorID(ctx context.Context, creatorID int64) ([]*model.Tag, error) {
+	query := `
+		SELECT ` + TAG_SELECT_COLUMNS + ` AS diary_count
+		FROM tags
+		WHERE tags.creator_id = $1
+		ORDER BY diary_count DESC, LOWER(tags.name) ASC
+	`
+
+	rows, err := r.db.DB.QueryContext(ctx, query, creatorID)
+	if err != nil {
+		return nil, apperror.ErrTagGetInternal
+	}
+	defer rows.Close()
+
+	tags := []*model.Tag{}
+	for rows.Next() {
+		tag, err := scanTag(rows)
+		if err != nil {
+			return nil, apperror.ErrTagGetInternal
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.ErrTagGetInternal
+	}
+
+	return tags, nil
+}
+
+// GetTagByID 함수는 주어진 ID와 생성자 ID로 태그를 조회합니다.
+func (r *tagRepository) GetTagByID(ctx context.Context, id int64, creatorID int64) (*model.Tag, error) {
+	query := `
+		SELECT ` + TAG_SELECT_COLUMNS + `
+		FROM tags
+		WHERE tags.id = $1 AND tags.creator_id = $2
+	`
+
+	tag, err := scanTag(r.db.DB.QueryRowContext(ctx, query, id, creatorID))
+	if err != nil {
+		if errors.Is(err, apperror.ErrTagNotFound) {
+			return nil, err
+		}
+		return nil, apperror.ErrTagGetInternal
+	}
+	return tag, nil
+}
+
+// FindTagNamesByPrefix 함수는 주어진 접두어로 시작하는 태그 이름을 대소문자 구분 없이 이름순으로 조회합니다.
+func (r *tagRepository) FindTagNamesByPrefix(ctx context.Context, creatorID int64, prefix string, limit int) ([]string, error) {
+	query := `
+		SELECT name
+		FROM tags
+		WHERE creator_id = $1 AND name ILIKE $2
+		ORDER BY LOWER(name) ASC
+		LIMIT $3
+	`
+
+	rows, err := r.db.DB.QueryContext(ctx, query, creatorID, escapeLikePattern(prefix)+"%", limit)
+	if err != nil {
+		return nil, apperror.ErrTagGetInternal
+	}
+	defer rows.Close()
+
+	names := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, apperror.ErrTagGetInternal
+		}
+		names = append(names, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperror.ErrTagGetInternal
+	}
+
+	return names, nil
+}
+
+// UpdateTagName 함수는 태그의 이름을 변경합니다. 같은 이름(대소문자 구분 없음)의 다른 태그가 있으면 ErrTagNameDuplicate를 반환합니다.
+func (r *tagRepository) UpdateTagName(ctx context.Context, tag *model.Tag) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperror.ErrTagUpdateInternal
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	query := `
+		UPDATE tags
+		SET name = $1
+		WHERE id = $2 AND creator_id = $3
+	`
+
+	result, err := tx.ExecContext(ctx, query, tag.Name, tag.ID, tag.CreatorID)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return apperror.ErrTagNameDuplicate
+		}
+		return apperror.ErrTagUpdateInternal
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return apperror.ErrTagUpdateInternal
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrTagNotFound
+	}
+
+	if err := bumpTaggedDiaryVersions(ctx, tx, tag.ID, tag.CreatorID); err != nil {
+		return apperror.ErrTagUpdateInternal
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperror.ErrTagUpdateInternal
+	}
+	return nil
+}
+
+// MergeTags 함수는 sourceID 태그가 지정된 일기를 모두 targetID 태그로 옮기고 sourceID 태그를 삭제합니다.
+// 두 태그가 모두 지정된 일기는 targetID 태그 하나만 남습니다.
+func (r *tagRepository) MergeTags(ctx context.Context, sourceID int64, targetID int64, creatorID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperror.ErrTagMergeInternal
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	moveDiaries := `
+		INSERT INTO diary_tags (diary_id, tag_id)
+		SELECT diary_tags.diary_id, $2::integer
+		FROM diary_tags
+		JOIN tags ON tags.id = diary_tags.tag_id
+		WHERE diary_tags.tag_id = $1 AND tags.creator_id = $3
+		ON CONFLICT (diary_id, tag_id) DO NOTHING
+	`
+	if _, err := tx.ExecContext(ctx, moveDiaries, sourceID, targetID, creatorID); err != nil {
+		return apperror.ErrTagMergeInternal
+	}
+
+	// 원본 태그가 지정되어 있던 일기는 태그 목록이 바뀌므로 버전을 올림
+	if err := bumpTaggedDiaryVersions(ctx, tx, sourceID, creatorID); err != nil {
+		return apperror.ErrTagMergeInternal
+	}
+
+	// 원본 태그를 삭제하면 남은 연결도 함께 삭제됨 (ON DELETE CASCADE)
+	result, err := tx.ExecContext(ctx, "DELETE FROM tags WHERE id = $1 AND creator_id = $2", sourceID, creatorID)
+	if err != nil {
+		return apperror.ErrTagMergeInternal
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return apperror.ErrTagMergeInternal
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrTagNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperror.ErrTagMergeInternal
+	}
+	return nil
+}
+
+// DeleteTag 함수는 태그를 삭제합니다. 태그가 지정된 일기에서는 태그만 해제됩니다.
+func (r *tagRepository) DeleteTag(ctx context.Context, id int64, creatorID int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperror.ErrTagDeleteInternal
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// 태그 연결이 함께 삭제되기 전에 태그가 지정된 일기의 버전을 올림
+	if err := bumpTaggedDiaryVersions(ctx, tx, id, creatorID); err != nil {
+		return apperror.ErrTagDeleteInternal
+	}
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM tags WHERE id = $1 AND creator_id = $2", id, creatorID)
+	if err != nil {
+		return apperror.ErrTagDeleteInternal
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return apperror.ErrTagDeleteInternal
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrTagNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperror.ErrTagDeleteInternal
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/lib/pq"
+)
+
+// UserIdentityRepository 인터페이스는 외부 로그인 계정 연결 관련 데이터베이스 작업을 정의합니다.
+type UserIdentityRepository interface {
+	FindIdentity(ctx context.Context, provider string, subject string) (*model.UserIdentity, error)
+	ListIdentities(ctx context.Context, userID int64) ([]*model.UserIdentity, error)
+	CountIdentities(ctx context.Context, userID int64) (int, error)
+	CreateIdentity(ctx context.Context, identity *model.UserIdentity) error
+	CreateUserWithIdentity(ctx context.Context, user *model.User, identity *model.UserIdentity) error
+	DeleteIdentity(ctx context.Context, userID int64, provider string) error
+}
+
+// USER_IDENTITY_SELECT_COLUMNS는 외부 계정 조회 시 공통으로 사용하는 컬럼 목록입니다. scanUserIdentity의 순서와 일치해야 합니다.
+const USER_IDENTITY_SELECT_COLUMNS = "id, user_id, provider, subject, COALESCE(email, ''), created_at"
+
+// userIdentityRepository 구조체는 UserIdentityRepository 인터페이스를 구현합니다.
+type userIdentityRepository struct {
+	db *database.DB
+}
+
+// NewUserIdentityRepository 함수는 UserIdentityRepository 인터페이스의 구현체를 반환합니다.
+func NewUserIdentityRepository(db *database.DB) UserIdentityRepository {
+	return &userIdentityRepository{db: db}
+}
+
+// scanUserIdentity 함수는 USER_IDENTITY_SELECT_COLUMNS 순서로 조회된 행을 model.UserIdentity로 변환합니다.
+func scanUserIdentity(row rowScanner) (*model.UserIdentity, error) {
+	identity := &model.UserIdentity{}
+	if err := row.Scan(&identity.ID, &identity.UserID, &identity.Provider, &identity.Subject, &identity.Email, &identity.CreatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperror.ErrOIDCIdentityNotFound
+		}
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+// mapUserIdentityUniqueViolation 함수는 user_identities 테이블의 유니크 제약 조건 위반 에러를 도메인 에러로 변환합니다.
+func mapUserIdentityUniqueViolation(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		if pqErr.Constraint == "unique_identity_provider_subject" {
+			return apperror.ErrOIDCIdentityAlreadyLinked
+		}
+
+		if pqErr.Constraint == "unique_identity_user_provider" {
+			return apperror.ErrOIDCProviderAlreadyLinked
+		}
+	}
+
+	return err
+}
+
+// FindIdentity 함수는 제공자와 subject로 연결된 외부 계정을 조회합니다.
+func (r *userIdentityRepository) FindIdentity(ctx context.Context, provider string, subject string) (*model.UserIdentity, error) {
+	query := `
+		SELECT ` + USER_IDENTITY_SELECT_COLUMNS + `
+		FROM user_identities
+		WHERE provider = $1 AND subject = $2
+	`
+
+	return scanUserIdentity(r.db.DB.QueryRowContext(ctx, query, provider, subject))
+}
+
+// ListIdentities 함수는 사용자에게 연결된 외부 계정 목록을 조회합니다.
+func (r *userIdentityRepository) ListIdentities(ctx context.Context, userID int64) ([]*model.UserIdentity, error) {
+	query := `
+		SELECT ` + USER_IDENTITY_SELECT_COLUMNS + `
+		FROM user_identities
+		WHERE user_id = $1
+		ORDER BY created_at ASC, id ASC
+	`
+
+	rows, err := r.db.DB.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	identities := []*model.UserIdentity{}
+	for rows.Next() {
+		identity, err := scanUserIdentity(rows)
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, identity)
+	}
+
+	return identities, rows.Err()
+}
+
+// CountIdentities 함수는 사용자에게 연결된 외부 계정 개수를 반환합니다.
+func (r *userIdentityRepository) CountIdentities(ctx context.Context, userID int64) (int, error) {
+	var count int
+	if err := r.db.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM user_identities WHERE user_id = $1", userID).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CreateIdentity 함수는 기존 사용자에게 외부 계정을 연결합니다.
+func (r *userIdentityRepository) CreateIdentity(ctx context.Context, identity *model.UserIdentity) error {
+	query := `
+		INSERT INTO user_identities (user_id, provider, subject, email)
+		VALUES ($1, $2, $3, NULLIF($4, ''))
+		RETURNING id, created_at
+	`
+
+	if err := r.db.DB.QueryRowContext(ctx, query, identity.UserID, identity.Provider, identity.Subject, identity.Email).Scan(&identity.ID, &identity.CreatedAt); err != nil {
+		return mapUserIdentityUniqueViolation(err)
+	}
+	return nil
+}
+
+// CreateUserWithIdentity 함수는 외부 계정으로 처음 로그인한 사용자를 생성하고 외부 계정을 연결합니다.
+// 비밀번호 없이 생성되므로 user.Password는 빈 문자열이어야 합니다.
+func (r *userIdentityRepository) CreateUserWithIdentity(ctx context.Context, user *model.User, identity *model.UserIdentity) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	userQuery := `
+		INSERT INTO users (username, nickname, password, email, email_verified_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, role, created_at
+	`
+	if err := tx.QueryRowContext(ctx, userQuery, user.Username, user.Nickname, user.Password, user.Email, user.EmailVerifiedAt).Scan(&user.ID, &user.Role, &user.CreatedAt); err != nil {
+		return mapUserUniqueViolation(err)
+	}
+
+	identityQuery := `
+		INSERT INTO user_identities (user_id, provider, subject, email)
+		VALUES ($1, $2, $3, NULLIF($4, ''))
+		RETURNING id, created_at
+	`
+	identity.UserID = user.ID
+	if err := tx.QueryRowContext(ctx, identityQuery, identity.UserID, identity.Provider, identity.Subject, identity.Email).Scan(&identity.ID, &identity.CreatedAt); err != nil {
+		return mapUserIdentityUniqueViolation(err)
+	}
+
+	return tx.Commit()
+}
+
+// DeleteIdentity 함수는 사용자에게 연결된 외부 계정 연결을 해제합니다.
+func (r *userIdentityRepository) DeleteIdentity(ctx context.Context, userID int64, provider string) error {
+	result, err := r.db.DB.ExecContext(ctx, "DELETE FROM user_identities WHERE user_id = $1 AND provider = $2", userID, provider)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrOIDCIdentityNotFound
+	}
+
+	return nil
+}
@@ -16,6 +16,19 @@ type UserRepository interface {
 	CreateUser(cxt context.Context, userSignupDTO dto.UserSignupDTO) (int64, error)
 	FindUserByUsername(ctx context.Context, username string) (*model.User, error)
 	FindUserByUserID(ctx context.Context, userID int64) (*model.User, error)
+	FindUserByEmail(ctx context.Context, email string) (*model.User, error)
+	UpdatePassword(ctx context.Context, userID int64, hashedPassword string) error
+	UpgradePasswordHash(ctx context.Context, userID int64, oldHash string, newHash string) error
+	UpdateProfile(ctx context.Context, user *model.User) error
+	MarkEmailVerified(ctx context.Context, userID int64, email string) error
+}
+
+// USER_SELECT_COLUMNS는 사용자 조회 시 공통으로 사용하는 컬럼 목록입니다. scanUser의 순서와 일치해야 합니다.
+const USER_SELECT_COLUMNS = "id, username, nickname, password, email, email_verified_at, deletion_scheduled_at, totp_secret, totp_enabled_at, role, disabled_at, timezone, diary_revision_limit, created_at"
+
+// rowScanner 인터페이스는 *sql.Row와 *sql.Rows를 함께 다루기 위한 인터페이스입니다.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
 }
 
 // userRepository 구조체는 UserRepository 인터페이스를 구현합니다.
@@ -38,58 +51,176 @@ func (r *userRepository) CreateUser(ctx context.Context, userSignupDTO dto.UserS
 	`
 
 	if err := r.db.DB.QueryRowContext(ctx, query, userSignupDTO.Username, userSignupDTO.Nickname, userSignupDTO.Password, userSignupDTO.Email).Scan(&id); err != nil {
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
-			if pqErr.Constraint == "users_username_key" {
-				return 0, apperror.ErrUserSignupDuplicateUserName
-			}
-
-			if pqErr.Constraint == "users_email_key" {
-				return 0, apperror.ErrUserSignupDuplicateEmail
-			}
+		return 0, mapUserUniqueViolation(err)
+	}
+
+	return id, nil
+}
+
+// scanUser 함수는 USER_SELECT_COLUMNS 순서로 조회된 행을 model.User로 변환합니다.
+func scanUser(row rowScanner) (*model.User, error) {
+	user := &model.User{}
+	if err := row.Scan(&user.ID, &user.Username, &user.Nickname, &user.Password, &user.Email, &user.EmailVerifiedAt, &user.DeletionScheduledAt, &user.TOTPSecret, &user.TOTPEnabledAt, &user.Role, &user.DisabledAt, &user.Timezone, &user.DiaryRevisionLimit, &user.CreatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, apperror.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// mapUserUniqueViolation 함수는 users 테이블의 유니크 제약 조건 위반 에러를 도메인 에러로 변환합니다.
+func mapUserUniqueViolation(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		if pqErr.Constraint == "users_username_key" {
+			return apperror.ErrUserSignupDuplicateUserName
 		}
 
-		return 0, err
+		if pqErr.Constraint == "users_email_key" {
+			return apperror.ErrUserSignupDuplicateEmail
+		}
 	}
 
-	return id, nil
+	return err
 }
 
 // FindUserByUsername 함수는 사용자 이름으로 사용자를 검색합니다.
 func (r *userRepository) FindUserByUsername(ctx context.Context, username string) (*model.User, error) {
-	user := &model.User{}
 	query := `
-		SELECT id, username, nickname, password, email, created_at
+		SELECT ` + USER_SELECT_COLUMNS + `
 		FROM users
 		WHERE username = $1
 	`
 
 	// 사용자 정보를 조회합니다.
-	if err := r.db.DB.QueryRowContext(ctx, query, username).Scan(&user.ID, &user.Username, &user.Nickname, &user.Password, &user.Email, &user.CreatedAt); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, apperror.ErrUserNotFound
-		}
-		return nil, err
-	}
-
-	return user, nil
+	return scanUser(r.db.DB.QueryRowContext(ctx, query, username))
 }
 
 // FindUserByUserID 함수는 사용자 ID로 사용자를 검색합니다.
 func (r *userRepository) FindUserByUserID(ctx context.Context, userID int64) (*model.User, error) {
-	user := &model.User{}
 	query := `
-		SELECT id, username, nickname, password, email, created_at
+		SELECT ` + USER_SELECT_COLUMNS + `
 		FROM users
 		WHERE id = $1
 	`
 
 	// 사용자 정보를 조회합니다.
-	if err := r.db.DB.QueryRowContext(ctx, query, userID).Scan(&user.ID, &user.Username, &user.Nickname, &user.Password, &user.Email, &user.CreatedAt); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, apperror.ErrUserNotFound
-		}
-		return nil, err
+	return scanUser(r.db.DB.QueryRowContext(ctx, query, userID))
+}
+
+// FindUserByEmail 함수는 이메일로 사용자를 검색합니다.
+func (r *userRepository) FindUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	query := `
+		SELECT ` + USER_SELECT_COLUMNS + `
+		FROM users
+		WHERE email = $1
+	`
+
+	// 사용자 정보를 조회합니다.
+	return scanUser(r.db.DB.QueryRowContext(ctx, query, email))
+}
+
+// UpdatePassword 함수는 사용자의 비밀번호 해시를 변경합니다.
+func (r *userRepository) UpdatePassword(ctx context.Context, userID int64, hashedPassword string) error {
+	query := `
+		UPDATE users
+		SET password = $1
+		WHERE id = $2
+	`
+
+	result, err := r.db.DB.ExecContext(ctx, query, hashedPassword, userID)
+	if err != nil {
+		return err
 	}
 
-	return user, nil
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// UpgradePasswordHash 함수는 같은 비밀번호의 해시를 새 알고리즘이나 파라미터로 만든 해시로 교체합니다.
+// 그 사이 비밀번호가 변경되었다면 덮어쓰지 않도록 기존 해시가 그대로일 때만 교체합니다.
+func (r *userRepository) UpgradePasswordHash(ctx context.Context, userID int64, oldHash string, newHash string) error {
+	query := `
+		UPDATE users
+		SET password = $1
+		WHERE id = $2 AND password = $3
+	`
+
+	_, err := r.db.DB.ExecContext(ctx, query, newHash, userID, oldHash)
+	return err
+}
+
+// UpdateProfile 함수는 사용자의 닉네임, 이메일, 시간대, 일기 수정 기록 보관 수를 변경합니다. 이메일이 바뀌면 인증 상태가 초기화되고,
+// 보관 수를 넘는 오래된 수정 기록은 같은 트랜잭션에서 삭제됩니다.
+func (r *userRepository) UpdateProfile(ctx context.Context, user *model.User) error {
+	query := `
+		UPDATE users
+		SET nickname = $1,
+			email = $2,
+			email_verified_at = CASE WHEN email = $2 THEN email_verified_at ELSE NULL END,
+			timezone = $3,
+			diary_revision_limit = $4
+		WHERE id = $5
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.ExecContext(ctx, query, user.Nickname, user.Email, user.Timezone, user.DiaryRevisionLimit, user.ID)
+	if err != nil {
+		return mapUserUniqueViolation(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrUserNotFound
+	}
+
+	// 수정 기록 보관 개수를 줄인 경우 초과한 기록을 바로 삭제
+	if err := pruneUserDiaryRevisions(ctx, tx, user.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// MarkEmailVerified 함수는 사용자의 이메일을 인증 완료 상태로 변경합니다.
+// 토큰 발급 이후 이메일이 변경된 경우에는 갱신하지 않습니다.
+func (r *userRepository) MarkEmailVerified(ctx context.Context, userID int64, email string) error {
+	query := `
+		UPDATE users
+		SET email_verified_at = COALESCE(email_verified_at, CURRENT_TIMESTAMP)
+		WHERE id = $1 AND email = $2
+	`
+
+	result, err := r.db.DB.ExecContext(ctx, query, userID, email)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperror.ErrUserNotFound
+	}
+
+	return nil
 }
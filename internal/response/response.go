@@ -13,7 +13,8 @@ type SuccessResponse struct {
 
 // ErrorResponse 구조체는 에러 응답의 JSON 구조를 정의합니다.
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Error   string      `json:"error"`
+	Details interface{} `json:"details,omitempty"`
 }
 
 // Success 함수는 성공 응답을 표준화된 JSON 형식으로 반환합니다.
@@ -34,3 +35,18 @@ func Error(w http.ResponseWriter, status int, message string) {
 		Error: message,
 	})
 }
+
+// ErrorWithDetails 함수는 오류 위치 등 추가 정보를 포함한 에러 응답을 반환합니다.
+func ErrorWithDetails(w http.ResponseWriter, status int, message string, details interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{
+		Error:   message,
+		Details: details,
+	})
+}
+
+// NotModified 함수는 클라이언트가 가진 응답이 최신일 때 본문 없이 304 상태를 반환합니다.
+func NotModified(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNotModified)
+}
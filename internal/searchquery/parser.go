@@ -0,0 +1,241 @@
+package searchquery
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// ParseError 구조체는 검색식 해석 오류와 오류가 발생한 글자(rune) 위치입니다.
+// 클라이언트가 입력창에서 오류 구간을 표시할 수 있도록 JSON으로 위치와 길이를 함께 전달합니다.
+type ParseError struct {
+	Position int    `json:"position"` // 0부터 시작하는 글자 위치
+	Length   int    `json:"length"`   // 오류 구간의 글자 수
+	Message  string `json:"message"`
+
+	Err error `json:"-"`
+}
+
+// newParseError 함수는 오류 구간 [start, end)로 ParseError를 만듭니다. 구간이 비어 있으면 길이를 1로 둡니다.
+func newParseError(err error, start int, end int) *ParseError {
+	return &ParseError{
+		Position: start,
+		Length:   max(end-start, 1),
+		Message:  err.Error(),
+		Err:      err,
+	}
+}
+
+// Error 함수는 오류 메시지에 위치를 붙여 반환합니다.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s (%d번째 글자)", e.Message, e.Position+1)
+}
+
+// Unwrap 함수는 원인 오류를 반환합니다.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// token 구조체는 공백으로 구분된 검색식 토큰입니다. 위치는 모두 글자(rune) 단위입니다.
+type token struct {
+	pos         int
+	end         int
+	negated     bool
+	hasOperator bool
+	operator    string // ':' 앞의 연산자 이름 ( 소문자 )
+	operatorPos int
+	value       string
+	valuePos    int
+	quoted      bool
+	closed      bool // 따옴표로 시작한 값의 닫는 따옴표 존재 여부
+}
+
+// isOperatorRune 함수는 연산자 이름에 사용할 수 있는 글자인지 확인합니다.
+func isOperatorRune(r rune) bool {
+	return r < utf8.RuneSelf && unicode.IsLetter(r)
+}
+
+// lex 함수는 검색식을 토큰으로 나눕니다. 닫히지 않은 따옴표도 토큰으로 만들어 자동 완성에서 사용할 수 있도록 합니다.
+func lex(input string) []token {
+	runes := []rune(input)
+	tokens := []token{}
+
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		tok := token{pos: i}
+		if runes[i] == '-' {
+			tok.negated = true
+			i++
+		}
+
+		// 영문자 뒤에 ':'가 오면 연산자 ( 예: category:work )
+		j := i
+		for j < len(runes) && isOperatorRune(runes[j]) {
+			j++
+		}
+		if j > i && j < len(runes) && runes[j] == ':' {
+			tok.hasOperator = true
+			tok.operator = strings.ToLower(string(runes[i:j]))
+			tok.operatorPos = i
+			i = j + 1
+		}
+
+		tok.valuePos = i
+		if i < len(runes) && runes[i] == '"' {
+			tok.quoted = true
+			i++
+			start := i
+			for i < len(runes) && runes[i] != '"' {
+				i++
+			}
+			tok.value = string(runes[start:i])
+			if i < len(runes) {
+				tok.closed = true
+				i++
+			}
+		} else {
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) {
+				i++
+			}
+			tok.value = string(runes[start:i])
+		}
+
+		tok.end = i
+		tokens = append(tokens, tok)
+	}
+
+	return tokens
+}
+
+// Parse 함수는 검색식을 해석합니다.
+// 지원 문법: 일반 검색어, "따옴표 구문", -제외, category:이름, before:YYYY-MM-DD, after:YYYY-MM-DD, has:image|category|mood, mood:감정, tag:태그
+// 오류가 있으면 위치가 포함된 *ParseError를 반환합니다.
+func Parse(input string) (*Query, error) {
+	query := &Query{Raw: input, Terms: []Term{}}
+
+	for _, tok := range lex(input) {
+		term, err := tok.toTerm()
+		if err != nil {
+			return nil, err
+		}
+		query.Terms = append(query.Terms, term)
+	}
+
+	return query, nil
+}
+
+// toTerm 함수는 토큰을 검색어로 변환하고 연산자 값의 유효성을 검사합니다.
+func (t token) toTerm() (Term, error) {
+	term := Term{
+		Kind:    TERM_TEXT,
+		Value:   t.value,
+		Negated: t.negated,
+		Pos:     t.pos,
+		End:     t.end,
+	}
+
+	if t.quoted && !t.closed {
+		return term, newParseError(apperror.ErrSearchQueryUnclosedQuote, t.valuePos, t.end)
+	}
+
+	if !t.hasOperator {
+		if t.quoted {
+			if strings.TrimSpace(t.value) == "" {
+				return term, newParseError(apperror.ErrSearchQueryEmptyPhrase, t.valuePos, t.end)
+			}
+			term.Kind = TERM_PHRASE
+		}
+		if t.value == "" {
+			return term, newParseError(apperror.ErrSearchQueryEmptyNegation, t.pos, t.end)
+		}
+		return term, nil
+	}
+
+	operator, ok := LookupOperator(t.operator)
+	if !ok {
+		return term, newParseError(apperror.ErrSearchQueryUnknownOperator, t.operatorPos, t.valuePos-1)
+	}
+	term.Kind = TERM_OPERATOR
+	term.Operator = operator.Name
+
+	term.Value = strings.TrimSpace(t.value)
+	if term.Value == "" {
+		return term, newParseError(apperror.ErrSearchQueryEmptyValue, t.valuePos, t.end)
+	}
+
+	switch operator.Name {
+	case OPERATOR_BEFORE, OPERATOR_AFTER:
+		date, err := time.Parse(DATE_LAYOUT, term.Value)
+		if err != nil {
+			return term, newParseError(apperror.ErrSearchQueryInvalidDate, t.valuePos, t.end)
+		}
+		term.Date = date
+	case OPERATOR_HAS:
+		term.Value = strings.ToLower(term.Value)
+		if term.Value != HAS_IMAGE && term.Value != HAS_CATEGORY && term.Value != HAS_MOOD {
+			return term, newParseError(apperror.ErrSearchQueryInvalidHasValue, t.valuePos, t.end)
+		}
+	case OPERATOR_CATEGORY:
+		if utf8.RuneCountInString(term.Value) > CATEGORY_NAME_MAX_LENGTH {
+			return term, newParseError(apperror.ErrSearchQueryCategoryTooLong, t.valuePos, t.end)
+		}
+	case OPERATOR_TAG:
+		term.Value = strings.TrimLeft(term.Value, "#")
+		if term.Value == "" {
+			return term, newParseError(apperror.ErrSearchQueryEmptyValue, t.valuePos, t.end)
+		}
+		if utf8.RuneCountInString(term.Value) > TAG_NAME_MAX_LENGTH {
+			return term, newParseError(apperror.ErrSearchQueryTagTooLong, t.valuePos, t.end)
+		}
+	case OPERATOR_MOOD:
+		// 감정 목록은 DB에서 관리하므로 존재 여부는 서비스 계층에서 확인
+		term.Value = strings.ToLower(term.Value)
+		if utf8.RuneCountInString(term.Value) > MOOD_CODE_MAX_LENGTH {
+			return term, newParseError(apperror.ErrSearchQueryUnknownMood, t.valuePos, t.end)
+		}
+	}
+
+	return term, nil
+}
+
+// Partial 구조체는 자동 완성 대상인 검색식의 마지막(입력 중인) 토큰입니다.
+type Partial struct {
+	Pos         int    // 자동 완성 결과로 교체할 시작 글자 위치
+	End         int    // 교체할 끝 글자 위치 ( 검색식 길이 )
+	Negated     bool   // -로 시작했는지 여부
+	HasOperator bool   // 연산자 값을 입력 중인지 여부
+	Operator    string // 입력 중인 연산자 이름
+	Value       string // 입력 중인 검색어 또는 연산자 값
+}
+
+// LastPartial 함수는 검색식 끝에서 입력 중인 토큰을 반환합니다. 검색식이 비어 있거나 공백으로 끝나면 빈 토큰을 반환합니다.
+// 자동 완성용이므로 닫히지 않은 따옴표 등 미완성 입력도 오류 없이 처리합니다.
+func LastPartial(input string) Partial {
+	length := utf8.RuneCountInString(input)
+	partial := Partial{Pos: length, End: length}
+
+	tokens := lex(input)
+	if len(tokens) == 0 {
+		return partial
+	}
+	last := tokens[len(tokens)-1]
+	if last.end != length || (last.quoted && last.closed) {
+		return partial
+	}
+
+	partial.Pos = last.pos
+	partial.Negated = last.negated
+	partial.HasOperator = last.hasOperator
+	partial.Operator = last.operator
+	partial.Value = last.value
+	return partial
+}
@@ -0,0 +1,179 @@
+package searchquery
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Term
+	}{
+		{
+			name:  "빈 검색식",
+			input: "   ",
+			want:  []Term{},
+		},
+		{
+			name:  "일반 검색어",
+			input: "hello  world",
+			want: []Term{
+				{Kind: TERM_TEXT, Value: "hello", Pos: 0, End: 5},
+				{Kind: TERM_TEXT, Value: "world", Pos: 7, End: 12},
+			},
+		},
+		{
+			name:  "구문과 제외 검색어",
+			input: `"good day" -rain`,
+			want: []Term{
+				{Kind: TERM_PHRASE, Value: "good day", Pos: 0, End: 10},
+				{Kind: TERM_TEXT, Value: "rain", Negated: true, Pos: 11, End: 16},
+			},
+		},
+		{
+			name:  "연산자 값 정규화",
+			input: "category:Work tag:#여행 has:IMAGE",
+			want: []Term{
+				{Kind: TERM_OPERATOR, Operator: OPERATOR_CATEGORY, Value: "Work", Pos: 0, End: 13},
+				{Kind: TERM_OPERATOR, Operator: OPERATOR_TAG, Value: "여행", Pos: 14, End: 21},
+				{Kind: TERM_OPERATOR, Operator: OPERATOR_HAS, Value: HAS_IMAGE, Pos: 22, End: 31},
+			},
+		},
+		{
+			name:  "제외 연산자와 날짜",
+			input: "-mood:Happy before:2026-05-01",
+			want: []Term{
+				{Kind: TERM_OPERATOR, Operator: OPERATOR_MOOD, Value: "happy", Negated: true, Pos: 0, End: 11},
+				{Kind: TERM_OPERATOR, Operator: OPERATOR_BEFORE, Value: "2026-05-01", Date: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC), Pos: 12, End: 29},
+			},
+		},
+		{
+			name:  "대문자 연산자와 따옴표 값",
+			input: `CATEGORY:"my work"`,
+			want: []Term{
+				{Kind: TERM_OPERATOR, Operator: OPERATOR_CATEGORY, Value: "my work", Pos: 0, End: 18},
+			},
+		},
+		{
+			name:  "영문자가 아닌 글자 뒤의 콜론",
+			input: "12:30 여행:제주",
+			want: []Term{
+				{Kind: TERM_TEXT, Value: "12:30", Pos: 0, End: 5},
+				{Kind: TERM_TEXT, Value: "여행:제주", Pos: 6, End: 11},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if query.Raw != tt.input {
+				t.Errorf("Raw = %q, want %q", query.Raw, tt.input)
+			}
+			if len(query.Terms) != len(tt.want) {
+				t.Fatalf("len(Terms) = %d, want %d (%+v)", len(query.Terms), len(tt.want), query.Terms)
+			}
+			for i, want := range tt.want {
+				if got := query.Terms[i]; !equalTerm(got, want) {
+					t.Errorf("Terms[%d] = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestParseRoundTrip은 각 검색어의 원문 구간 [Pos, End)만 다시 해석해도 같은 검색어가 되는지 확인합니다.
+func TestParseRoundTrip(t *testing.T) {
+	inputs := []string{
+		"hello world",
+		`"good day" -rain`,
+		"category:Work tag:#여행 has:IMAGE",
+		"-mood:Happy before:2026-05-01 after:2026-01-01",
+		`  일기   -"비 오는 날"  CATEGORY:"my work"  `,
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			query, err := Parse(input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", input, err)
+			}
+
+			runes := []rune(input)
+			for _, term := range query.Terms {
+				raw := string(runes[term.Pos:term.End])
+				reparsed, err := Parse(raw)
+				if err != nil {
+					t.Fatalf("Parse(%q) error = %v", raw, err)
+				}
+				if len(reparsed.Terms) != 1 {
+					t.Fatalf("Parse(%q) terms = %d, want 1", raw, len(reparsed.Terms))
+				}
+
+				got := reparsed.Terms[0]
+				want := term
+				want.Pos, want.End = 0, utf8.RuneCountInString(raw)
+				if !equalTerm(got, want) {
+					t.Errorf("Parse(%q) = %+v, want %+v", raw, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseErrorPosition(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		wantErr      error
+		wantPosition int
+		wantLength   int
+	}{
+		{name: "닫히지 않은 따옴표", input: `hello "unclosed`, wantErr: apperror.ErrSearchQueryUnclosedQuote, wantPosition: 6, wantLength: 9},
+		{name: "알 수 없는 연산자", input: "foo:bar", wantErr: apperror.ErrSearchQueryUnknownOperator, wantPosition: 0, wantLength: 3},
+		{name: "잘못된 날짜", input: "after:2026-01-01 before:2026-13-01", wantErr: apperror.ErrSearchQueryInvalidDate, wantPosition: 24, wantLength: 10},
+		{name: "잘못된 has 값", input: "has:video", wantErr: apperror.ErrSearchQueryInvalidHasValue, wantPosition: 4, wantLength: 5},
+		{name: "빈 제외 검색어", input: "a -", wantErr: apperror.ErrSearchQueryEmptyNegation, wantPosition: 2, wantLength: 1},
+		{name: "빈 구문", input: `"  " x`, wantErr: apperror.ErrSearchQueryEmptyPhrase, wantPosition: 0, wantLength: 4},
+		{name: "빈 연산자 값", input: "tag:", wantErr: apperror.ErrSearchQueryEmptyValue, wantPosition: 4, wantLength: 1},
+		{name: "# 만 있는 태그", input: "tag:###", wantErr: apperror.ErrSearchQueryEmptyValue, wantPosition: 4, wantLength: 3},
+		{name: "긴 카테고리 이름", input: "category:" + strings.Repeat("a", CATEGORY_NAME_MAX_LENGTH+1), wantErr: apperror.ErrSearchQueryCategoryTooLong, wantPosition: 9, wantLength: CATEGORY_NAME_MAX_LENGTH + 1},
+		{name: "글자 단위 위치", input: "여행 has:x", wantErr: apperror.ErrSearchQueryInvalidHasValue, wantPosition: 7, wantLength: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.input)
+			if err == nil {
+				t.Fatalf("Parse(%q) error = nil, want %v", tt.input, tt.wantErr)
+			}
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse(%q) error type = %T, want *ParseError", tt.input, err)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if parseErr.Position != tt.wantPosition || parseErr.Length != tt.wantLength {
+				t.Errorf("Parse(%q) position = (%d, %d), want (%d, %d)", tt.input, parseErr.Position, parseErr.Length, tt.wantPosition, tt.wantLength)
+			}
+		})
+	}
+}
+
+// equalTerm 함수는 두 검색어가 같은지 비교합니다.
+func equalTerm(a Term, b Term) bool {
+	return a.Kind == b.Kind && a.Operator == b.Operator && a.Value == b.Value && a.Date.Equal(b.Date) &&
+		a.Negated == b.Negated && a.Pos == b.Pos && a.End == b.End
+}
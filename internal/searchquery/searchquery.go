@@ -0,0 +1,123 @@
+package searchquery
+
+import (
+	"strings"
+	"time"
+)
+
+// 검색어 종류
+const (
+	TERM_TEXT     = "text"     // 공백으로 구분된 일반 검색어
+	TERM_PHRASE   = "phrase"   // 따옴표로 감싼 구문 ( 공백 포함 그대로 검색 )
+	TERM_OPERATOR = "operator" // name:value 형태의 필드 연산자
+)
+
+// 검색 연산자
+const (
+	OPERATOR_CATEGORY = "category" // 카테고리 이름 ( 대소문자 구분 없음 )
+	OPERATOR_BEFORE   = "before"   // 해당 날짜 이전 작성 ( 해당 날짜 미포함 )
+	OPERATOR_AFTER    = "after"    // 해당 날짜부터 작성 ( 해당 날짜 포함 )
+	OPERATOR_HAS      = "has"      // 이미지, 카테고리, 감정 보유 여부
+	OPERATOR_MOOD     = "mood"     // 감정 태그 코드 ( 대소문자 구분 없음 )
+	OPERATOR_TAG      = "tag"      // 태그 이름 ( 대소문자 구분 없음 )
+
+	HAS_IMAGE    = "image"
+	HAS_CATEGORY = "category"
+	HAS_MOOD     = "mood"
+)
+
+const (
+	DATE_LAYOUT              = "2006-01-02" // before, after 연산자의 날짜 형식
+	CATEGORY_NAME_MAX_LENGTH = 50           // categories.name 컬럼 길이
+	MOOD_CODE_MAX_LENGTH     = 30           // moods.code 컬럼 길이
+	TAG_NAME_MAX_LENGTH      = 30           // tags.name 컬럼 길이
+)
+
+// Operator 구조체는 검색 연산자와 자동 완성에 표시할 설명입니다.
+type Operator struct {
+	Name        string
+	Description string
+	Values      []string // 사용할 수 있는 값이 정해진 경우의 값 목록
+}
+
+// Operators는 지원하는 검색 연산자 목록입니다. 자동 완성에도 이 순서대로 표시됩니다.
+var Operators = []Operator{
+	{Name: OPERATOR_CATEGORY, Description: "카테고리 이름으로 찾기 (예: category:work)"},
+	{Name: OPERATOR_BEFORE, Description: "해당 날짜 이전에 작성한 일기 (예: before:2026-05-01)"},
+	{Name: OPERATOR_AFTER, Description: "해당 날짜부터 작성한 일기 (예: after:2026-05-01)"},
+	{Name: OPERATOR_HAS, Description: "이미지, 카테고리 또는 감정이 있는 일기 (예: has:image)", Values: []string{HAS_IMAGE, HAS_CATEGORY, HAS_MOOD}},
+	{Name: OPERATOR_MOOD, Description: "감정으로 찾기 (예: mood:happy)"},
+	{Name: OPERATOR_TAG, Description: "태그로 찾기 (예: tag:여행)"},
+}
+
+// LookupOperator 함수는 이름으로 검색 연산자를 찾습니다.
+func LookupOperator(name string) (Operator, bool) {
+	for _, operator := range Operators {
+		if operator.Name == name {
+			return operator, true
+		}
+	}
+	return Operator{}, false
+}
+
+// Term 구조체는 검색식을 구성하는 검색어 하나입니다. Pos, End는 원문에서의 글자(rune) 위치 [Pos, End)입니다.
+type Term struct {
+	Kind     string
+	Operator string    // Kind가 TERM_OPERATOR인 경우의 연산자 이름
+	Value    string    // 검색어, 구문 또는 연산자 값
+	Date     time.Time // before, after 연산자의 날짜
+	Negated  bool      // -로 시작하여 일치하는 일기를 제외하는지 여부
+	Pos      int
+	End      int
+}
+
+// Error 함수는 검색어 전체 구간을 오류 위치로 하는 ParseError를 만듭니다.
+// 감정 코드처럼 해석 이후 서비스 계층에서 확인하는 값의 오류를 검색식 오류와 같은 형식으로 전달할 때 사용합니다.
+func (t Term) Error(err error) *ParseError {
+	return newParseError(err, t.Pos, t.End)
+}
+
+// Query 구조체는 해석된 검색식입니다. 모든 검색어는 AND로 결합됩니다.
+type Query struct {
+	Raw   string
+	Terms []Term
+
+	// Location은 before, after 날짜를 해석할 사용자 시간대입니다. nil이면 UTC를 사용합니다.
+	Location *time.Location
+}
+
+// IsEmpty 함수는 검색 조건이 없는지 여부를 반환합니다.
+func (q *Query) IsEmpty() bool {
+	return q == nil || len(q.Terms) == 0
+}
+
+// DayStart 함수는 before, after 연산자 날짜의 시작 시각(0시)을 검색식의 시간대 기준으로 반환합니다.
+func (q *Query) DayStart(date time.Time) time.Time {
+	location := time.UTC
+	if q.Location != nil {
+		location = q.Location
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location)
+}
+
+// TextTerms 함수는 제외 검색어를 뺀 일반 검색어와 구문을 중복 없이 반환합니다. 관련도 계산과 강조 표시에 사용합니다.
+func (q *Query) TextTerms() []string {
+	terms := []string{}
+	if q == nil {
+		return terms
+	}
+
+	seen := map[string]bool{}
+	for _, term := range q.Terms {
+		if term.Negated || (term.Kind != TERM_TEXT && term.Kind != TERM_PHRASE) {
+			continue
+		}
+		key := strings.ToLower(term.Value)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		terms = append(terms, term.Value)
+	}
+	return terms
+}
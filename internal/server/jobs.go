@@ -0,0 +1,28 @@
+package server
+
+import (
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/internal/database"
+	"github.com/jhphon0730/dairify/internal/job"
+	"github.com/jhphon0730/dairify/internal/repository"
+	"github.com/jhphon0730/dairify/internal/service"
+)
+
+// SetupJobs는 백그라운드 작업을 등록한 스케줄러를 반환합니다.
+func SetupJobs(db *database.DB) job.Scheduler {
+	cfg := config.GetConfig()
+
+	userRepository := repository.NewUserRepository(db)
+	accountRepository := repository.NewAccountRepository(db)
+	accountService := service.NewAccountService(userRepository, accountRepository)
+	categoryRepository := repository.NewCategoryRepository(db)
+	tagRepository := repository.NewTagRepository(db)
+	diaryRepository := repository.NewDiaryRepository(db)
+	diaryService := service.NewDiaryService(diaryRepository, categoryRepository, tagRepository, userRepository)
+
+	scheduler := job.NewScheduler()
+	scheduler.Register(job.NewAccountDeletionJob(accountService), cfg.AccountDeletionJobInterval)
+	scheduler.Register(job.NewDiaryPurgeJob(diaryService), cfg.DiaryPurgeJobInterval)
+
+	return scheduler
+}
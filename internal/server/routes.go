@@ -13,22 +13,47 @@ import (
 // SetupRoutes는 HTTP 라우트를 설정합니다.
 func SetupRoutes(mux *http.ServeMux, db *database.DB) {
 	userRepository := repository.NewUserRepository(db)
-	userService := service.NewUserService(userRepository)
+	mfaRepository := repository.NewMFARepository(db)
+	signinLockoutRepository := repository.NewSigninLockoutRepository(db)
+	userService := service.NewUserService(userRepository, mfaRepository, signinLockoutRepository)
+	mfaService := service.NewMFAService(userRepository, mfaRepository)
+	personalAccessTokenRepository := repository.NewPersonalAccessTokenRepository(db)
+	personalAccessTokenService := service.NewPersonalAccessTokenService(personalAccessTokenRepository)
+	userIdentityRepository := repository.NewUserIdentityRepository(db)
+	oidcService := service.NewOIDCService(userRepository, userIdentityRepository)
+	accountRepository := repository.NewAccountRepository(db)
+	accountService := service.NewAccountService(userRepository, accountRepository)
 	categoryRepository := repository.NewCategoryRepository(db)
 	categoryService := service.NewCategoryService(categoryRepository)
+	tagRepository := repository.NewTagRepository(db)
+	tagService := service.NewTagService(tagRepository)
 	diaryRepository := repository.NewDiaryRepository(db)
-	diaryService := service.NewDiaryService(diaryRepository)
+	diaryService := service.NewDiaryService(diaryRepository, categoryRepository, tagRepository, userRepository)
+	adminRepository := repository.NewAdminRepository(db)
+	adminService := service.NewAdminService(adminRepository, signinLockoutRepository)
+	auditEventRepository := repository.NewAuditEventRepository(db)
+	auditService := service.NewAuditService(auditEventRepository)
 
 	userHandler := handler.NewUserHandler(userService)
+	accountHandler := handler.NewAccountHandler(accountService)
+	mfaHandler := handler.NewMFAHandler(mfaService)
+	personalAccessTokenHandler := handler.NewPersonalAccessTokenHandler(personalAccessTokenService)
+	oidcHandler := handler.NewOIDCHandler(oidcService)
 	categoryHandler := handler.NewCategoryHandler(categoryService)
+	tagHandler := handler.NewTagHandler(tagService)
 	diaryHandler := handler.NewDiaryHandler(diaryService)
+	adminHandler := handler.NewAdminHandler(adminService)
+	auditHandler := handler.NewAuditHandler(auditService)
 
 	// HTTP 연결 상태 확인 라우트 설정
 	RegisterHealthRoutes(mux)
+	RegisterWellKnownRoutes(mux, handler.NewJWKSHandler())
 
-	RegisterUserRoutes(mux, userHandler)
+	RegisterUserRoutes(mux, userHandler, accountHandler, mfaHandler, personalAccessTokenHandler, oidcHandler, auditHandler)
 	RegisterCategoryRoutes(mux, categoryHandler)
+	RegisterTagRoutes(mux, tagHandler)
 	RegisterDiaryRoutes(mux, diaryHandler)
+	RegisterAdminRoutes(mux, adminHandler, auditHandler)
 }
 
 // RegisterHealthRoutes는 헬스 체크 라우트를 등록합니다.
@@ -39,14 +64,46 @@ func RegisterHealthRoutes(mux *http.ServeMux) {
 	})
 }
 
+// RegisterWellKnownRoutes는 다른 서비스가 참조하는 /.well-known/ 라우트를 등록합니다.
+func RegisterWellKnownRoutes(mux *http.ServeMux, jwksHandler handler.JWKSHandler) {
+	mux.HandleFunc("/.well-known/jwks.json", middleware.LoggingMiddleware(jwksHandler.GetJWKS)) // JWT 검증용 공개 키
+}
+
 // RegisterUserRoutes는 사용자 관련 라우트를 등록합니다.
-func RegisterUserRoutes(mux *http.ServeMux, userHandler handler.UserHandler) {
+func RegisterUserRoutes(mux *http.ServeMux, userHandler handler.UserHandler, accountHandler handler.AccountHandler, mfaHandler handler.MFAHandler, personalAccessTokenHandler handler.PersonalAccessTokenHandler, oidcHandler handler.OIDCHandler, auditHandler handler.AuditHandler) {
 	api_v1_users := http.NewServeMux()
 
-	api_v1_users.HandleFunc("/signup/", middleware.LoggingMiddleware(userHandler.SignupUser))                // 회원가입
-	api_v1_users.HandleFunc("/signin/", middleware.LoggingMiddleware(userHandler.SigninUser))                // 로그인
-	api_v1_users.HandleFunc("/signout/", middleware.ChainLoggingWithAuthMiddleware(userHandler.SignoutUser)) // 로그아웃
-	api_v1_users.HandleFunc("/profile/", middleware.ChainLoggingWithAuthMiddleware(userHandler.ProfileUser)) // 프로필 조회
+	api_v1_users.HandleFunc("/signup/", middleware.LoggingMiddleware(userHandler.SignupUser))                                                // 회원가입
+	api_v1_users.HandleFunc("/signin/", middleware.LoggingMiddleware(userHandler.SigninUser))                                                // 로그인
+	api_v1_users.HandleFunc("/signin/mfa/", middleware.LoggingMiddleware(userHandler.SigninMFA))                                             // 로그인 2단계 인증
+	api_v1_users.HandleFunc("/oidc/{provider}/authorize/", middleware.LoggingMiddleware(oidcHandler.Authorize))                              // 외부 계정 로그인 시작
+	api_v1_users.HandleFunc("/oidc/{provider}/callback/", middleware.LoggingMiddleware(oidcHandler.Callback))                                // 외부 계정 로그인 완료
+	api_v1_users.HandleFunc("/signout/", middleware.ChainLoggingWithSessionMiddleware(userHandler.SignoutUser))                              // 로그아웃 (현재 세션)
+	api_v1_users.HandleFunc("/signout/others/", middleware.ChainLoggingWithSessionMiddleware(userHandler.SignoutOtherSessions))              // 다른 모든 세션 로그아웃
+	api_v1_users.HandleFunc("/refresh/", middleware.LoggingMiddleware(userHandler.RefreshUser))                                              // 토큰 재발급
+	api_v1_users.HandleFunc("/profile/", middleware.ChainLoggingWithAuthWriteMiddleware(userHandler.ProfileUser))                            // 프로필 조회 및 수정
+	api_v1_users.HandleFunc("/password/", middleware.ChainLoggingWithSessionMiddleware(userHandler.ChangePassword))                          // 비밀번호 변경
+	api_v1_users.HandleFunc("/password-reset/request/", middleware.LoggingMiddleware(userHandler.RequestPasswordReset))                      // 비밀번호 재설정 메일 요청
+	api_v1_users.HandleFunc("/password-reset/confirm/", middleware.LoggingMiddleware(userHandler.ConfirmPasswordReset))                      // 비밀번호 재설정 확정
+	api_v1_users.HandleFunc("/verify-email/", middleware.LoggingMiddleware(userHandler.VerifyEmail))                                         // 이메일 인증
+	api_v1_users.HandleFunc("/verify-email/resend/", middleware.LoggingMiddleware(userHandler.ResendVerificationEmail))                      // 인증 메일 재발송
+	api_v1_users.HandleFunc("/sessions/", middleware.ChainLoggingWithSessionMiddleware(userHandler.GetSessions))                             // 세션 목록 조회
+	api_v1_users.HandleFunc("/sessions/{id}/", middleware.ChainLoggingWithSessionMiddleware(userHandler.RevokeSession))                      // 세션 폐기
+	api_v1_users.HandleFunc("/mfa/", middleware.ChainLoggingWithSessionMiddleware(mfaHandler.Status))                                        // 2단계 인증 상태 조회
+	api_v1_users.HandleFunc("/mfa/totp/setup/", middleware.ChainLoggingWithSessionWriteMiddleware(mfaHandler.SetupTOTP))                     // TOTP 등록 시작
+	api_v1_users.HandleFunc("/mfa/totp/confirm/", middleware.ChainLoggingWithSessionWriteMiddleware(mfaHandler.ConfirmTOTP))                 // TOTP 등록 확인 및 복구 코드 발급
+	api_v1_users.HandleFunc("/mfa/totp/disable/", middleware.ChainLoggingWithSessionWriteMiddleware(mfaHandler.DisableTOTP))                 // TOTP 해제
+	api_v1_users.HandleFunc("/mfa/recovery-codes/", middleware.ChainLoggingWithSessionWriteMiddleware(mfaHandler.RegenerateRecoveryCodes))   // 복구 코드 재발급
+	api_v1_users.HandleFunc("/tokens/", middleware.ChainLoggingWithSessionWriteMiddleware(personalAccessTokenHandler.Tokens))                // 개인 액세스 토큰 목록 조회 및 발급
+	api_v1_users.HandleFunc("/tokens/{id}/", middleware.ChainLoggingWithSessionWriteMiddleware(personalAccessTokenHandler.RevokeToken))      // 개인 액세스 토큰 폐기
+	api_v1_users.HandleFunc("/identities/", middleware.ChainLoggingWithSessionMiddleware(oidcHandler.GetIdentities))                         // 연결된 외부 계정 목록 조회
+	api_v1_users.HandleFunc("/identities/{provider}/", middleware.ChainLoggingWithSessionWriteMiddleware(oidcHandler.Unlink))                // 외부 계정 연결 해제
+	api_v1_users.HandleFunc("/identities/{provider}/link/", middleware.ChainLoggingWithSessionWriteMiddleware(oidcHandler.StartLink))        // 외부 계정 연결 시작
+	api_v1_users.HandleFunc("/identities/{provider}/callback/", middleware.ChainLoggingWithSessionWriteMiddleware(oidcHandler.LinkCallback)) // 외부 계정 연결 완료
+	api_v1_users.HandleFunc("/account/", middleware.ChainLoggingWithSessionWriteMiddleware(accountHandler.DeleteAccount))                    // 계정 삭제 예약
+	api_v1_users.HandleFunc("/account/cancel-deletion/", middleware.ChainLoggingWithSessionMiddleware(accountHandler.CancelDeletion))        // 계정 삭제 취소
+	api_v1_users.HandleFunc("/account/export/", middleware.ChainLoggingWithSessionMiddleware(accountHandler.ExportAccount))                  // 계정 데이터 내보내기
+	api_v1_users.HandleFunc("/security-events/", middleware.ChainLoggingWithSessionMiddleware(auditHandler.GetSecurityEvents))               // 보안 기록 조회
 
 	mux.Handle("/api/v1/users/", http.StripPrefix("/api/v1/users", api_v1_users))
 }
@@ -55,24 +112,64 @@ func RegisterUserRoutes(mux *http.ServeMux, userHandler handler.UserHandler) {
 func RegisterCategoryRoutes(mux *http.ServeMux, categoryHandler handler.CategoryHandler) {
 	api_v1_categories := http.NewServeMux()
 
-	api_v1_categories.HandleFunc("/create/", middleware.ChainLoggingWithAuthMiddleware(categoryHandler.CreateCategory))         // 카테고리 생성
-	api_v1_categories.HandleFunc("/list/", middleware.ChainLoggingWithAuthMiddleware(categoryHandler.GetCategoriesByCreatorID)) // 카테고리 목록 조회
-	api_v1_categories.HandleFunc("/update/{id}/", middleware.ChainLoggingWithAuthMiddleware(categoryHandler.UpdateCategory))    // 카테고리 이름 업데이트
-	api_v1_categories.HandleFunc("/delete/{id}/", middleware.ChainLoggingWithAuthMiddleware(categoryHandler.DeleteCategory))    // 카테고리 삭제
+	api_v1_categories.HandleFunc("/create/", middleware.ChainLoggingWithAuthWriteMiddleware(categoryHandler.CreateCategory))      // 카테고리 생성
+	api_v1_categories.HandleFunc("/list/", middleware.ChainLoggingWithAuthMiddleware(categoryHandler.GetCategoriesByCreatorID))   // 카테고리 목록 조회
+	api_v1_categories.HandleFunc("/update/{id}/", middleware.ChainLoggingWithAuthWriteMiddleware(categoryHandler.UpdateCategory)) // 카테고리 이름 업데이트
+	api_v1_categories.HandleFunc("/delete/{id}/", middleware.ChainLoggingWithAuthWriteMiddleware(categoryHandler.DeleteCategory)) // 카테고리 삭제
 
 	mux.Handle("/api/v1/categories/", http.StripPrefix("/api/v1/categories", api_v1_categories))
 }
 
+// RegisterTagRoutes는 태그 관리 라우트를 등록합니다. 태그 생성은 일기 생성, 수정 시 함께 처리됩니다.
+func RegisterTagRoutes(mux *http.ServeMux, tagHandler handler.TagHandler) {
+	api_v1_tags := http.NewServeMux()
+
+	api_v1_tags.HandleFunc("/list/", middleware.ChainLoggingWithAuthMiddleware(tagHandler.GetTagsByCreatorID))    // 태그 목록, 일기 수 조회
+	api_v1_tags.HandleFunc("/update/{id}/", middleware.ChainLoggingWithAuthWriteMiddleware(tagHandler.UpdateTag)) // 태그 이름 변경
+	api_v1_tags.HandleFunc("/merge/{id}/", middleware.ChainLoggingWithAuthWriteMiddleware(tagHandler.MergeTag))   // 다른 태그로 병합
+	api_v1_tags.HandleFunc("/delete/{id}/", middleware.ChainLoggingWithAuthWriteMiddleware(tagHandler.DeleteTag)) // 태그 삭제
+
+	mux.Handle("/api/v1/tags/", http.StripPrefix("/api/v1/tags", api_v1_tags))
+}
+
 // RegisterDiaryRoutes는 일기 관련 라우트를 등록합니다.
 func RegisterDiaryRoutes(mux *http.ServeMux, diaryHandler handler.DiaryHandler) {
 	api_v1_diaries := http.NewServeMux()
 
-	api_v1_diaries.HandleFunc("/list/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.GetDiariesByCreatorID))         // 일기 목록 조회
-	api_v1_diaries.HandleFunc("/create/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.CreateDiary))                 // 일기 생성
-	api_v1_diaries.HandleFunc("/detail/{id}/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.GetDiaryByID))           // 일기 단건 조회
-	api_v1_diaries.HandleFunc("/delete/{id}/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.DeleteDiary))            // 일기 삭제
-	api_v1_diaries.HandleFunc("/update/{id}/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.UpdateDiary))            // 일기 수정
-	api_v1_diaries.HandleFunc("/upload-image/{id}/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.UploadDiaryImage)) // 일기 이미지 업로드
+	api_v1_diaries.HandleFunc("/list/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.GetDiariesByCreatorID))                                  // 일기 목록 조회
+	api_v1_diaries.HandleFunc("/search/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.SearchDiaries))                                        // 일기 제목, 본문 검색
+	api_v1_diaries.HandleFunc("/search/suggest/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.SuggestSearch))                                // 검색식 자동 완성
+	api_v1_diaries.HandleFunc("/calendar/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.GetDiaryCalendar))                                   // 월간 캘린더 조회
+	api_v1_diaries.HandleFunc("/moods/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.ListMoods))                                             // 감정 목록 조회
+	api_v1_diaries.HandleFunc("/moods/stats/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.GetMoodStats))                                    // 기간별 감정 분포 조회
+	api_v1_diaries.HandleFunc("/create/", middleware.ChainLoggingWithAuthWriteMiddleware(diaryHandler.CreateDiary))                                     // 일기 생성
+	api_v1_diaries.HandleFunc("/detail/{id}/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.GetDiaryByID))                                    // 일기 단건 조회
+	api_v1_diaries.HandleFunc("/delete/{id}/", middleware.ChainLoggingWithAuthWriteMiddleware(diaryHandler.DeleteDiary))                                // 일기 삭제
+	api_v1_diaries.HandleFunc("/trash/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.GetTrashedDiaries))                                     // 휴지통 일기 목록 조회
+	api_v1_diaries.HandleFunc("/restore/{id}/", middleware.ChainLoggingWithAuthWriteMiddleware(diaryHandler.RestoreDiary))                              // 휴지통 일기 복원
+	api_v1_diaries.HandleFunc("/purge/{id}/", middleware.ChainLoggingWithAuthWriteMiddleware(diaryHandler.PurgeDiary))                                  // 휴지통 일기 영구 삭제
+	api_v1_diaries.HandleFunc("/update/{id}/", middleware.ChainLoggingWithAuthWriteMiddleware(diaryHandler.UpdateDiary))                                // 일기 수정
+	api_v1_diaries.HandleFunc("PATCH /update/{id}/", middleware.ChainLoggingWithAuthWriteMiddleware(diaryHandler.PatchDiary))                           // 일기 부분 수정 ( JSON Merge Patch )
+	api_v1_diaries.HandleFunc("/upload-image/{id}/", middleware.ChainLoggingWithAuthWriteMiddleware(diaryHandler.UploadDiaryImage))                     // 일기 이미지 업로드
+	api_v1_diaries.HandleFunc("/revisions/{id}/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.ListDiaryRevisions))                           // 수정 기록 목록 조회
+	api_v1_diaries.HandleFunc("/revisions/diff/{id}/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.DiffDiaryRevisions))                      // 수정 기록 비교
+	api_v1_diaries.HandleFunc("/revisions/{id}/{revision}/", middleware.ChainLoggingWithAuthMiddleware(diaryHandler.GetDiaryRevision))                  // 수정 기록 단건 조회
+	api_v1_diaries.HandleFunc("/revisions/restore/{id}/{revision}/", middleware.ChainLoggingWithAuthWriteMiddleware(diaryHandler.RestoreDiaryRevision)) // 수정 기록 복원
 
 	mux.Handle("/api/v1/diaries/", http.StripPrefix("/api/v1/diaries", api_v1_diaries))
 }
+
+// RegisterAdminRoutes는 관리자 전용 라우트를 등록합니다.
+func RegisterAdminRoutes(mux *http.ServeMux, adminHandler handler.AdminHandler, auditHandler handler.AuditHandler) {
+	api_v1_admin := http.NewServeMux()
+
+	api_v1_admin.HandleFunc("/users/", middleware.ChainLoggingWithAdminMiddleware(adminHandler.ListUsers))                        // 사용자 목록 조회 및 검색
+	api_v1_admin.HandleFunc("/users/{id}/", middleware.ChainLoggingWithAdminMiddleware(adminHandler.GetUser))                     // 사용자 정보 및 사용 현황 조회
+	api_v1_admin.HandleFunc("/users/{id}/disable/", middleware.ChainLoggingWithAdminMiddleware(adminHandler.DisableUser))         // 계정 비활성화
+	api_v1_admin.HandleFunc("/users/{id}/enable/", middleware.ChainLoggingWithAdminMiddleware(adminHandler.EnableUser))           // 계정 활성화
+	api_v1_admin.HandleFunc("/users/{id}/sessions/", middleware.ChainLoggingWithAdminMiddleware(adminHandler.RevokeUserSessions)) // 세션 강제 폐기
+	api_v1_admin.HandleFunc("/security-events/", middleware.ChainLoggingWithAdminMiddleware(auditHandler.ListSecurityEvents))     // 전체 사용자 보안 기록 조회
+	api_v1_admin.HandleFunc("/signin-lockouts/", middleware.ChainLoggingWithAdminMiddleware(adminHandler.ListSigninLockouts))     // 로그인 잠금 기록 조회
+
+	mux.Handle("/api/v1/admin/", http.StripPrefix("/api/v1/admin", api_v1_admin))
+}
@@ -18,8 +18,8 @@ const (
 	// CORS 설정
 	CORS_ALLOW_ORIGIN      = "*" // 예: "https://example.com"
 	CORS_ALLOW_METHODS     = "GET,POST,PUT,PATCH,DELETE,OPTIONS"
-	CORS_ALLOW_HEADERS     = "Content-Type,Authorization"
-	CORS_EXPOSE_HEADERS    = "" // 노출할 헤더가 없으면 빈 문자열 유지
+	CORS_ALLOW_HEADERS     = "Content-Type,Authorization,If-Match,If-None-Match"
+	CORS_EXPOSE_HEADERS    = "ETag" // 노출할 헤더가 없으면 빈 문자열 유지
 	CORS_ALLOW_CREDENTIALS = false
 	CORS_MAX_AGE           = "86400" // 24시간
 
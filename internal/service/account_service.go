@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/mailer"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/redis"
+	"github.com/jhphon0730/dairify/internal/repository"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+const (
+	ACCOUNT_EXPORT_FILE_NAME    = "dairify-export.json" // 최종 내보내기 첨부 파일 이름
+	ACCOUNT_EXPORT_CONTENT_TYPE = "application/json"
+)
+
+// AccountService 인터페이스는 계정 삭제 및 내보내기 관련 서비스의 메서드를 정의합니다.
+type AccountService interface {
+	ScheduleDeletion(ctx context.Context, userID int64, sessionID string, accountDeleteDTO dto.AccountDeleteDTO) (*model.User, int, error)
+	CancelDeletion(ctx context.Context, userID int64) (int, error)
+	ExportAccount(ctx context.Context, userID int64) (*dto.AccountExportDTO, int, error)
+	PurgeScheduledAccounts(ctx context.Context) error
+}
+
+// accountService 구조체는 AccountService 인터페이스를 구현합니다.
+type accountService struct {
+	userRepository    repository.UserRepository
+	accountRepository repository.AccountRepository
+}
+
+// NewAccountService 함수는 AccountService 인터페이스의 구현체를 반환합니다.
+func NewAccountService(userRepository repository.UserRepository, accountRepository repository.AccountRepository) AccountService {
+	return &accountService{
+		userRepository:    userRepository,
+		accountRepository: accountRepository,
+	}
+}
+
+// ScheduleDeletion 함수는 비밀번호를 확인한 뒤 유예 기간 이후로 계정 삭제를 예약합니다.
+// 외부 로그인으로 가입해 비밀번호가 없는 사용자는 비밀번호 확인 없이 예약하며, 예약 안내 메일로 취소할 기회를 줍니다.
+// 현재 세션을 제외한 다른 세션은 모두 로그아웃되며, 유예 기간 동안 다시 로그인하여 삭제를 취소할 수 있습니다.
+func (s *accountService) ScheduleDeletion(ctx context.Context, userID int64, sessionID string, accountDeleteDTO dto.AccountDeleteDTO) (*model.User, int, error) {
+	if err := accountDeleteDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	user, err := s.userRepository.FindUserByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+
+	// 비밀번호 확인 (비밀번호가 없는 외부 로그인 계정은 확인할 비밀번호가 없음)
+	if user.HasPassword() {
+		if strings.TrimSpace(accountDeleteDTO.Password) == "" {
+			return nil, http.StatusBadRequest, apperror.ErrAccountPasswordRequired
+		}
+		if err := utils.CompareHashAndPassword(user.Password, accountDeleteDTO.Password); err != nil {
+			return nil, http.StatusUnauthorized, apperror.ErrAccountInvalidPassword
+		}
+	}
+
+	cfg := config.GetConfig()
+	user, err = s.accountRepository.ScheduleDeletion(ctx, userID, int64(cfg.AccountDeletionGracePeriod.Seconds()))
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrAccountDeletionScheduleFailed
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if err := userRedisClient.DeleteUserSessions(ctx, userID, sessionID); err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	if mailClient, err := mailer.GetMailer(); err == nil {
+		mailer.SendAsync(mailClient, &mailer.Message{
+			To:      user.Email,
+			Subject: "[Dairify] 계정 삭제가 예약되었습니다",
+			Body: fmt.Sprintf("%s님, 안녕하세요.\n\n계정이 %s에 영구 삭제될 예정입니다. 삭제 시점에 모든 데이터를 내보낸 파일을 이 주소로 보내드립니다.\n\n삭제를 원하지 않으시면 그 전에 로그인하여 삭제를 취소해주세요.\n",
+				user.Nickname, user.DeletionScheduledAt.Format(time.RFC1123)),
+		})
+	}
+
+	return user, http.StatusOK, nil
+}
+
+// CancelDeletion 함수는 예약된 계정 삭제를 취소합니다.
+func (s *accountService) CancelDeletion(ctx context.Context, userID int64) (int, error) {
+	if err := s.accountRepository.CancelDeletion(ctx, userID); err != nil {
+		if errors.Is(err, apperror.ErrAccountDeletionNotScheduled) {
+			return http.StatusConflict, err
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
+
+// ExportAccount 함수는 사용자가 소유한 모든 데이터를 하나의 구조로 모아 반환합니다.
+func (s *accountService) ExportAccount(ctx context.Context, userID int64) (*dto.AccountExportDTO, int, error) {
+	user, err := s.userRepository.FindUserByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+
+	categories, err := s.accountRepository.GetCategoriesForExport(ctx, userID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	diaries, err := s.accountRepository.GetDiariesForExport(ctx, userID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return &dto.AccountExportDTO{
+		ExportedAt: time.Now(),
+		User:       user,
+		Categories: categories,
+		Diaries:    diaries,
+	}, http.StatusOK, nil
+}
+
+// PurgeScheduledAccounts 함수는 유예 기간이 끝난 계정을 영구 삭제합니다.
+// 삭제 직전에 데이터를 내보내고, 삭제가 완료되면 내보낸 파일을 사용자에게 메일로 발송합니다.
+func (s *accountService) PurgeScheduledAccounts(ctx context.Context) error {
+	users, err := s.accountRepository.FindUsersDueForDeletion(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, user := range users {
+		if err := s.purgeAccount(ctx, user); err != nil {
+			if errors.Is(err, apperror.ErrAccountDeletionCancelled) {
+				continue
+			}
+			errs = append(errs, fmt.Errorf("user %d: %w", user.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// purgeAccount 함수는 한 사용자의 데이터를 내보낸 뒤 DB 행, 이미지 파일, 세션 순서로 삭제합니다.
+func (s *accountService) purgeAccount(ctx context.Context, user *model.User) error {
+	export, _, err := s.ExportAccount(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	exportData, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	filePaths, err := s.accountRepository.DeleteUserData(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+
+	// DB 삭제가 확정된 이후의 정리 작업은 실패해도 로그만 남김
+	if err := utils.RemoveFiles(filePaths); err != nil {
+		log.Printf("Failed to remove image files of deleted user %d: %v", user.ID, err)
+	}
+
+	if userRedisClient, err := redis.GetUserRedis(ctx); err == nil {
+		if err := userRedisClient.DeleteUserSessions(ctx, user.ID, ""); err != nil {
+			log.Printf("Failed to remove sessions of deleted user %d: %v", user.ID, err)
+		}
+	}
+
+	mailClient, err := mailer.GetMailer()
+	if err != nil {
+		log.Printf("Failed to send final export to deleted user %d: %v", user.ID, err)
+		return nil
+	}
+	mailer.SendAsync(mailClient, &mailer.Message{
+		To:      user.Email,
+		Subject: "[Dairify] 계정이 삭제되었습니다",
+		Body: fmt.Sprintf("%s님, 안녕하세요.\n\n요청하신 대로 계정과 모든 데이터가 삭제되었습니다. 삭제 직전의 데이터를 첨부 파일로 보내드립니다.\n\n그동안 Dairify를 이용해주셔서 감사합니다.\n",
+			user.Nickname),
+		Attachments: []mailer.Attachment{
+			{FileName: ACCOUNT_EXPORT_FILE_NAME, ContentType: ACCOUNT_EXPORT_CONTENT_TYPE, Data: exportData},
+		},
+	})
+
+	return nil
+}
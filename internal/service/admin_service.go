@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/jhphon0730/dairify/internal/audit"
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/redis"
+	"github.com/jhphon0730/dairify/internal/repository"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// AdminService 인터페이스는 관리자용 사용자 관리 서비스의 메서드를 정의합니다.
+type AdminService interface {
+	ListUsers(ctx context.Context, listUsersDTO dto.AdminListUsersDTO) (*dto.AdminListUsersResponseDTO, int, error)
+	GetUser(ctx context.Context, userID int64) (*model.AdminUserSummary, int, error)
+	DisableUser(ctx context.Context, adminID int64, userID int64) (*model.AdminUserSummary, int, error)
+	EnableUser(ctx context.Context, adminID int64, userID int64) (*model.AdminUserSummary, int, error)
+	RevokeUserSessions(ctx context.Context, adminID int64, userID int64) (int, int, error)
+	ListSigninLockouts(ctx context.Context, listDTO dto.AdminListSigninLockoutsDTO) (*dto.AdminListSigninLockoutsResponseDTO, int, error)
+}
+
+// adminService 구조체는 AdminService 인터페이스를 구현합니다.
+type adminService struct {
+	adminRepository         repository.AdminRepository
+	signinLockoutRepository repository.SigninLockoutRepository
+}
+
+// NewAdminService 함수는 AdminService 인터페이스의 구현체를 반환합니다.
+func NewAdminService(adminRepository repository.AdminRepository, signinLockoutRepository repository.SigninLockoutRepository) AdminService {
+	return &adminService{
+		adminRepository:         adminRepository,
+		signinLockoutRepository: signinLockoutRepository,
+	}
+}
+
+// ListUsers 함수는 검색 조건에 맞는 사용자 목록과 사용자별 일기 수, 저장 공간 사용량을 반환합니다.
+func (s *adminService) ListUsers(ctx context.Context, listUsersDTO dto.AdminListUsersDTO) (*dto.AdminListUsersResponseDTO, int, error) {
+	if err := listUsersDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	users, total, err := s.adminRepository.ListUsers(ctx, listUsersDTO)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	return &dto.AdminListUsersResponseDTO{
+		Users: users,
+		Total: total,
+		Page:  listUsersDTO.Page,
+		Limit: listUsersDTO.Limit,
+	}, http.StatusOK, nil
+}
+
+// GetUser 함수는 사용자 정보와 일기 수, 저장 공간 사용량을 반환합니다.
+func (s *adminService) GetUser(ctx context.Context, userID int64) (*model.AdminUserSummary, int, error) {
+	summary, err := s.adminRepository.FindUserSummary(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	return summary, http.StatusOK, nil
+}
+
+// DisableUser 함수는 사용자 계정을 비활성화하고 모든 세션을 폐기합니다.
+// 비활성화된 계정은 로그인, 토큰 재발급, 개인 액세스 토큰 사용이 모두 거부됩니다. 관리자 계정은 비활성화할 수 없습니다.
+func (s *adminService) DisableUser(ctx context.Context, adminID int64, userID int64) (*model.AdminUserSummary, int, error) {
+	summary, status, err := s.GetUser(ctx, userID)
+	if err != nil {
+		return nil, status, err
+	}
+	if summary.User.IsAdmin() {
+		return nil, http.StatusForbidden, apperror.ErrAdminCannotDisableAdmin
+	}
+
+	if err := s.adminRepository.SetUserDisabled(ctx, userID, true); err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrAdminUserDisableFailed
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	// 세션 폐기와 동시에 진행 중이던 로그인이 세션을 만들 수 있으므로, 남은 토큰이 만료될 때까지 미들웨어에서도 거부
+	if err := userRedisClient.SetUserDisabled(ctx, userID, config.GetConfig().Redis.RefreshTokenExpiry); err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrAdminUserDisableFailed
+	}
+	if err := userRedisClient.DeleteUserSessions(ctx, userID, ""); err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrAdminSessionRevokeFailed
+	}
+	audit.RecordByAdmin(ctx, model.AUDIT_EVENT_ADMIN_USER_DISABLE, adminID, userID, nil)
+
+	return s.GetUser(ctx, userID)
+}
+
+// EnableUser 함수는 비활성화된 사용자 계정을 다시 활성화합니다.
+func (s *adminService) EnableUser(ctx context.Context, adminID int64, userID int64) (*model.AdminUserSummary, int, error) {
+	if err := s.adminRepository.SetUserDisabled(ctx, userID, false); err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrAdminUserDisableFailed
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if err := userRedisClient.DeleteUserDisabled(ctx, userID); err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrAdminUserDisableFailed
+	}
+	audit.RecordByAdmin(ctx, model.AUDIT_EVENT_ADMIN_USER_ENABLE, adminID, userID, nil)
+
+	return s.GetUser(ctx, userID)
+}
+
+// RevokeUserSessions 함수는 사용자의 모든 로그인 세션을 강제로 폐기하고 폐기한 세션 수를 반환합니다.
+// 개인 액세스 토큰은 세션이 아니므로 유지됩니다.
+func (s *adminService) RevokeUserSessions(ctx context.Context, adminID int64, userID int64) (int, int, error) {
+	if _, status, err := s.GetUser(ctx, userID); err != nil {
+		return 0, status, err
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return 0, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	sessions, err := userRedisClient.ListSessions(ctx, userID)
+	if err != nil {
+		return 0, http.StatusInternalServerError, apperror.ErrAdminSessionRevokeFailed
+	}
+	if err := userRedisClient.DeleteUserSessions(ctx, userID, ""); err != nil {
+		return 0, http.StatusInternalServerError, apperror.ErrAdminSessionRevokeFailed
+	}
+	audit.RecordByAdmin(ctx, model.AUDIT_EVENT_ADMIN_SESSIONS_REVOKE, adminID, userID, map[string]string{model.AUDIT_META_COUNT: strconv.Itoa(len(sessions))})
+
+	return len(sessions), http.StatusOK, nil
+}
+
+// ListSigninLockouts 함수는 로그인 실패 누적으로 발생한 잠금 기록을 최신순으로 반환합니다.
+func (s *adminService) ListSigninLockouts(ctx context.Context, listDTO dto.AdminListSigninLockoutsDTO) (*dto.AdminListSigninLockoutsResponseDTO, int, error) {
+	if err := listDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	lockouts, total, err := s.signinLockoutRepository.ListLockouts(ctx, listDTO)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	return &dto.AdminListSigninLockoutsResponseDTO{
+		Lockouts: lockouts,
+		Total:    total,
+		Page:     listDTO.Page,
+		Limit:    listDTO.Limit,
+	}, http.StatusOK, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/repository"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// AuditService 인터페이스는 보안 감사 로그 조회 서비스의 메서드를 정의합니다.
+type AuditService interface {
+	ListUserEvents(ctx context.Context, userID int64, listDTO dto.AuditEventListDTO) (*dto.AuditEventListResponseDTO, int, error)
+	ListEvents(ctx context.Context, listDTO dto.AuditEventListDTO) (*dto.AuditEventListResponseDTO, int, error)
+}
+
+// auditService 구조체는 AuditService 인터페이스를 구현합니다.
+type auditService struct {
+	auditEventRepository repository.AuditEventRepository
+}
+
+// NewAuditService 함수는 AuditService 인터페이스의 구현체를 반환합니다.
+func NewAuditService(auditEventRepository repository.AuditEventRepository) AuditService {
+	return &auditService{
+		auditEventRepository: auditEventRepository,
+	}
+}
+
+// ListUserEvents 함수는 사용자 본인의 보안 기록을 최신순으로 반환합니다.
+func (s *auditService) ListUserEvents(ctx context.Context, userID int64, listDTO dto.AuditEventListDTO) (*dto.AuditEventListResponseDTO, int, error) {
+	listDTO.UserID = &userID
+	return s.ListEvents(ctx, listDTO)
+}
+
+// ListEvents 함수는 조건에 맞는 보안 기록을 최신순으로 반환합니다. 사용자 조건이 없으면 전체 사용자의 기록을 조회합니다.
+func (s *auditService) ListEvents(ctx context.Context, listDTO dto.AuditEventListDTO) (*dto.AuditEventListResponseDTO, int, error) {
+	if err := listDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	events, total, err := s.auditEventRepository.ListEvents(ctx, listDTO)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrAuditInternalServer
+	}
+
+	return &dto.AuditEventListResponseDTO{
+		Events: events,
+		Total:  total,
+		Page:   listDTO.Page,
+		Limit:  listDTO.Limit,
+	}, http.StatusOK, nil
+}
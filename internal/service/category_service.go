@@ -14,7 +14,7 @@ import (
 // CategoryService 인터페이스는 카테고리 관련 서비스의 메서드를 정의합니다.
 type CategoryService interface {
 	CreateCategory(ctx context.Context, createCategoryDTO dto.CreateCategoryDTO) (*model.Category, int, error)
-	GetCategoriesByCreatorID(ctx context.Context, creatorID int64) ([]model.Category, int, error)
+	GetCategoriesByCreatorID(ctx context.Context, creatorID int64, page dto.CursorPageDTO) (*dto.GetCategoriesResponseDTO, int, error)
 	UpdateCategoryName(ctx context.Context, updateCategoryDTO dto.UpdateCategoryDTO) (*model.Category, int, error)
 	DeleteCategory(ctx context.Context, categoryID int64, creatorID int64) (int, error)
 }
@@ -45,13 +45,31 @@ func (s *categoryService) CreateCategory(ctx context.Context, createCategoryDTO
 	return category, http.StatusCreated, nil
 }
 
-// GetCategoriesByCreatorID 함수는 주어진 생성자 ID로 카테고리 목록을 조회합니다.
-func (s *categoryService) GetCategoriesByCreatorID(ctx context.Context, creatorID int64) ([]model.Category, int, error) {
-	categories, err := s.categoryRepository.GetCategoriesByCreatorID(ctx, creatorID)
+// GetCategoriesByCreatorID 함수는 주어진 생성자 ID로 카테고리 목록을 최신 순으로 한 페이지 조회합니다.
+func (s *categoryService) GetCategoriesByCreatorID(ctx context.Context, creatorID int64, page dto.CursorPageDTO) (*dto.GetCategoriesResponseDTO, int, error) {
+	if err := page.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	categories, err := s.categoryRepository.GetCategoriesByCreatorID(ctx, creatorID, page)
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
-	return categories, http.StatusOK, nil
+
+	res := &dto.GetCategoriesResponseDTO{}
+	res.Categories, res.CursorPageInfoDTO = dto.NewCursorPage(categories, page, func(category model.Category) dto.PageCursor {
+		return newPageCursor(category.CreatedAt, category.ID)
+	})
+
+	if page.IncludeTotal {
+		total, err := s.categoryRepository.CountCategoriesByCreatorID(ctx, creatorID)
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		res.Total = &total
+	}
+
+	return res, http.StatusOK, nil
 }
 
 // UpdateCategoryName 함수는 카테고리 이름을 업데이트합니다.
@@ -3,46 +3,140 @@ package service
 import (
 	"context"
 	"errors"
+	"log"
+	"math"
 	"mime/multipart"
 	"net/http"
-	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/jhphon0730/dairify/internal/config"
 	"github.com/jhphon0730/dairify/internal/dto"
 	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/redis"
 	"github.com/jhphon0730/dairify/internal/repository"
+	"github.com/jhphon0730/dairify/internal/searchquery"
 	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+const (
+	RECENT_SEARCH_TERMS_MAX    = 20                  // 사용자별로 보관하는 최근 검색어 수
+	RECENT_SEARCH_TERMS_EXPIRY = 90 * 24 * time.Hour // 최근 검색어 보관 기간 ( 마지막 검색 기준 )
+
+	// 검색 자동 완성 항목 종류
+	SEARCH_SUGGESTION_OPERATOR = "operator"
+	SEARCH_SUGGESTION_VALUE    = "value"
+	SEARCH_SUGGESTION_CATEGORY = "category"
+	SEARCH_SUGGESTION_RECENT   = "recent"
+	SEARCH_SUGGESTION_MOOD     = "mood"
+	SEARCH_SUGGESTION_TAG      = "tag"
 )
 
 // DiaryService는 일기 관련 비즈니스 로직을 처리하는 인터페이스입니다.
 type DiaryService interface {
 	GetDiaryByID(ctx context.Context, diaryID int64) (*model.Diary, int, error)
-	GetDiariesByCreatorID(ctx context.Context, creatorID int64, params url.Values) ([]model.Diary, int, error)
+	GetDiariesByCreatorID(ctx context.Context, creatorID int64, filter dto.DiaryListFilterDTO, page dto.CursorPageDTO) (*dto.GetDiariesByCreatorIDResponseDTO, int, error)
 	CreateDiary(ctx context.Context, diary dto.CreateDiaryDTO, creatorID int64) (*model.Diary, int, error)
 	DeleteDiary(ctx context.Context, diaryID int64, creatorID int64) (int, error)
-	UpdateDiary(ctx context.Context, updateDTO dto.UpdateDiaryDTO, diaryID int64, creatorID int64) (int, error)
+	UpdateDiary(ctx context.Context, updateDTO dto.UpdateDiaryDTO, diaryID int64, creatorID int64) (*model.Diary, int, error)
+	PatchDiary(ctx context.Context, patchDTO dto.PatchDiaryDTO, diaryID int64, creatorID int64) (*model.Diary, int, error)
 	UploadDiaryImage(ctx context.Context, files []*multipart.FileHeader, diaryID int64, creatorID int64) ([]*model.DiaryImage, int, error)
+	SearchDiaries(ctx context.Context, creatorID int64, searchDTO dto.SearchDiariesDTO) (*dto.SearchDiariesResponseDTO, int, error)
+	SuggestSearch(ctx context.Context, creatorID int64, input string) (*dto.DiarySearchSuggestionsResponseDTO, int, error)
+	GetDiaryCalendar(ctx context.Context, creatorID int64, calendarDTO dto.DiaryCalendarDTO) (*dto.DiaryCalendarResponseDTO, int, error)
+	ListMoods(ctx context.Context) ([]*model.Mood, int, error)
+	GetMoodStats(ctx context.Context, creatorID int64, statsDTO dto.MoodStatsDTO) (*dto.MoodStatsResponseDTO, int, error)
+	ListDiaryRevisions(ctx context.Context, diaryID int64, userID int64) (*dto.DiaryRevisionsResponseDTO, int, error)
+	GetDiaryRevision(ctx context.Context, diaryID int64, revisionNumber int, userID int64) (*model.DiaryRevision, int, error)
+	DiffDiaryRevisions(ctx context.Context, diaryID int64, userID int64, diffDTO dto.DiaryRevisionDiffDTO) (*dto.DiaryRevisionDiffResponseDTO, int, error)
+	RestoreDiaryRevision(ctx context.Context, diaryID int64, revisionNumber int, userID int64) (*model.Diary, int, error)
+	GetTrashedDiaries(ctx context.Context, creatorID int64, page dto.CursorPageDTO) (*dto.GetTrashedDiariesResponseDTO, int, error)
+	RestoreDiary(ctx context.Context, diaryID int64, creatorID int64) (*model.Diary, int, error)
+	PurgeDiary(ctx context.Context, diaryID int64, creatorID int64) (int, error)
+	PurgeExpiredDiaries(ctx context.Context) error
 }
 
 // diaryService 구조체는 DiaryService 인터페이스를 구현합니다.
 type diaryService struct {
-	diaryRepository repository.DiaryRepository
+	diaryRepository    repository.DiaryRepository
+	categoryRepository repository.CategoryRepository
+	tagRepository      repository.TagRepository
+	userRepository     repository.UserRepository
 }
 
 // NewDiaryService 함수는 DiaryService 인터페이스의 구현체를 반환합니다.
-func NewDiaryService(diaryRepository repository.DiaryRepository) DiaryService {
+func NewDiaryService(diaryRepository repository.DiaryRepository, categoryRepository repository.CategoryRepository, tagRepository repository.TagRepository, userRepository repository.UserRepository) DiaryService {
 	return &diaryService{
-		diaryRepository: diaryRepository,
+		diaryRepository:    diaryRepository,
+		categoryRepository: categoryRepository,
+		tagRepository:      tagRepository,
+		userRepository:     userRepository,
+	}
+}
+
+// getUserLocation 함수는 날짜 필터와 캘린더 계산에 사용할 사용자의 시간대를 조회합니다.
+func (s *diaryService) getUserLocation(ctx context.Context, userID int64) (*time.Location, int, error) {
+	user, err := s.userRepository.FindUserByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
 	}
+	return userLocation(user), http.StatusOK, nil
 }
 
-// GetDiariesByCreatorID 함수는 주어진 생성자 ID로 일기 목록을 조회합니다.
-func (s *diaryService) GetDiariesByCreatorID(ctx context.Context, creatorID int64, params url.Values) ([]model.Diary, int, error) {
-	diaries, err := s.diaryRepository.GetDiariesByCreatorID(ctx, creatorID, params)
+// GetDiariesByCreatorID 함수는 주어진 생성자 ID로 일기 목록을 최신 순으로 한 페이지 조회합니다.
+// 응답의 next_cursor, prev_cursor로 다음/이전 페이지를 조회할 수 있으며, 검색식이 있으면 조건에 맞는 일기만 조회합니다.
+// 날짜 필터와 검색식의 날짜는 사용자 시간대 기준으로 해석합니다.
+func (s *diaryService) GetDiariesByCreatorID(ctx context.Context, creatorID int64, filter dto.DiaryListFilterDTO, page dto.CursorPageDTO) (*dto.GetDiariesByCreatorIDResponseDTO, int, error) {
+	location, status, err := s.getUserLocation(ctx, creatorID)
+	if err != nil {
+		return nil, status, err
+	}
+	filter.Location = location
+
+	if err := filter.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if status, err := s.validateMoodCodes(ctx, filter.Moods); err != nil {
+		return nil, status, err
+	}
+	if status, err := s.validateSearchMoods(ctx, filter.Search); err != nil {
+		return nil, status, err
+	}
+	if err := page.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	diaries, err := s.diaryRepository.GetDiariesByCreatorID(ctx, creatorID, filter, page)
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
 
-	return diaries, http.StatusOK, nil
+	res := &dto.GetDiariesByCreatorIDResponseDTO{}
+	res.Diaries, res.CursorPageInfoDTO = dto.NewCursorPage(diaries, page, func(diary model.Diary) dto.PageCursor {
+		return newPageCursor(diary.CreatedAt, diary.ID)
+	})
+
+	if page.IncludeTotal {
+		total, err := s.diaryRepository.CountDiariesByCreatorID(ctx, creatorID, filter)
+		if err != nil {
+			return nil, http.StatusInternalServerError, err
+		}
+		res.Total = &total
+	}
+
+	// 첫 페이지 조회일 때만 최근 검색어로 기록
+	if page.Position == nil {
+		s.recordRecentSearchTerms(ctx, creatorID, filter.Search)
+	}
+
+	return res, http.StatusOK, nil
 }
 
 // CreateDiary 함수는 새로운 일기를 생성합니다.
@@ -50,6 +144,14 @@ func (s *diaryService) CreateDiary(ctx context.Context, diary dto.CreateDiaryDTO
 	if err := diary.Validate(); err != nil {
 		return nil, http.StatusBadRequest, err
 	}
+	if diary.Mood != nil {
+		if status, err := s.validateMoodCodes(ctx, []string{*diary.Mood}); err != nil {
+			return nil, status, err
+		}
+	}
+	if status, err := s.validateDiaryCategory(ctx, diary.CategoryID, creatorID); err != nil {
+		return nil, status, err
+	}
 
 	diaryModel := diary.ToModel(creatorID)
 	if err := s.diaryRepository.CreateDiary(ctx, diaryModel); err != nil {
@@ -101,36 +203,130 @@ func (s *diaryService) DeleteDiary(ctx context.Context, diaryID int64, creatorID
 	return http.StatusOK, nil
 }
 
-func (s *diaryService) UpdateDiary(ctx context.Context, updateDTO dto.UpdateDiaryDTO, diaryID int64, creatorID int64) (int, error) {
+// UpdateDiary 함수는 일기를 수정하고 수정된 일기를 반환합니다. 카테고리는 변경하지 않습니다.
+// If-Match 헤더 값이 현재 ETag와 다르거나 수정 도중 다른 요청이 먼저 수정하면 412 상태와 함께 서버의 현재 일기를 반환합니다.
+func (s *diaryService) UpdateDiary(ctx context.Context, updateDTO dto.UpdateDiaryDTO, diaryID int64, creatorID int64) (*model.Diary, int, error) {
+	if err := updateDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	diary, status, err := s.getDiaryForUpdate(ctx, diaryID, creatorID, updateDTO.IfMatch)
+	if err != nil {
+		return diary, status, err
+	}
+
+	return s.saveDiaryUpdate(ctx, diary, updateDTO, diary.CategoryID)
+}
+
+// PatchDiary 함수는 JSON Merge Patch 형식으로 일기의 일부 항목만 수정하고 수정된 일기를 반환합니다.
+// 카테고리를 지정하면 요청한 사용자의 카테고리인지 확인하며, null로 보내면 카테고리를 해제합니다.
+func (s *diaryService) PatchDiary(ctx context.Context, patchDTO dto.PatchDiaryDTO, diaryID int64, creatorID int64) (*model.Diary, int, error) {
+	if err := patchDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	diary, status, err := s.getDiaryForUpdate(ctx, diaryID, creatorID, patchDTO.IfMatch)
+	if err != nil {
+		return diary, status, err
+	}
+
+	// 현재 값에 부분 수정 내용을 합친 뒤 전체 수정과 같은 규칙으로 검사
+	updateDTO := patchDTO.Apply(diary)
 	if err := updateDTO.Validate(); err != nil {
-		return http.StatusBadRequest, err
+		return nil, http.StatusBadRequest, err
 	}
 
+	categoryID := patchDTO.CategoryFor(diary)
+	if patchDTO.Has(dto.DIARY_PATCH_FIELD_CATEGORY_ID) {
+		if status, err := s.validateDiaryCategory(ctx, categoryID, creatorID); err != nil {
+			return nil, status, err
+		}
+	}
+
+	return s.saveDiaryUpdate(ctx, diary, updateDTO, categoryID)
+}
+
+// getDiaryForUpdate 함수는 수정할 일기를 조회하고 작성자와 If-Match 헤더 값을 확인합니다.
+// 클라이언트가 가진 버전이 이미 오래되었으면 412 상태와 함께 서버의 현재 일기를 반환합니다.
+func (s *diaryService) getDiaryForUpdate(ctx context.Context, diaryID int64, creatorID int64, ifMatch string) (*model.Diary, int, error) {
 	// 일기 조회를 먼저 수행하여 업데이터 하려는 다이어리가 존재하는 지 확인
 	diary := &model.Diary{ID: diaryID}
 	err := s.diaryRepository.GetDiaryByID(ctx, diary)
 	if err != nil {
 		if errors.Is(err, apperror.ErrDiaryNotFound) {
-			return http.StatusNotFound, err
+			return nil, http.StatusNotFound, err
 		}
-		return http.StatusInternalServerError, apperror.ErrDiaryGetInternal
+		return nil, http.StatusInternalServerError, apperror.ErrDiaryGetInternal
 	}
 
 	// 다이어리와, 사용자의 권한을 확인합니다.
 	if diary.CreatorID != creatorID {
-		return http.StatusForbidden, apperror.ErrDiaryUpdateForbidden
+		return nil, http.StatusForbidden, apperror.ErrDiaryUpdateForbidden
 	}
 
-	diary = updateDTO.ToModel()
-	diary.ID = diaryID
+	if ifMatch != "" && !utils.MatchIfMatch(ifMatch, diary.ETag()) {
+		return s.diaryVersionConflict(ctx, diaryID)
+	}
+	return diary, http.StatusOK, nil
+}
+
+// saveDiaryUpdate 함수는 검사를 마친 수정 요청을 현재 일기에 반영하여 저장하고 수정된 일기를 반환합니다.
+func (s *diaryService) saveDiaryUpdate(ctx context.Context, diary *model.Diary, updateDTO dto.UpdateDiaryDTO, categoryID *int64) (*model.Diary, int, error) {
+	// 감정, 태그를 변경하지 않는 요청이면 기존 값을 유지
+	updated := updateDTO.ToModel()
+	updated.ID = diary.ID
+	updated.CategoryID = categoryID
+	if updateDTO.Tags == nil {
+		updated.Tags = diary.Tags
+	}
+	if !updateDTO.MoodChanged() {
+		updated.Mood, updated.MoodIntensity = diary.Mood, diary.MoodIntensity
+	} else if updated.Mood != nil {
+		if status, err := s.validateMoodCodes(ctx, []string{*updated.Mood}); err != nil {
+			return nil, status, err
+		}
+	}
+	// 조회 이후 다른 요청이 먼저 수정하는 경우도 막도록 확인한 버전을 저장 조건으로 사용
+	if updateDTO.IfMatch != "" {
+		updated.Version = diary.Version
+	}
 
-	if err := s.diaryRepository.UpdateDiary(ctx, diary); err != nil {
-		return http.StatusInternalServerError, apperror.ErrDiaryUpdateInternal
+	if err := s.diaryRepository.UpdateDiary(ctx, updated, nil); err != nil {
+		if errors.Is(err, apperror.ErrDiaryVersionConflict) {
+			return s.diaryVersionConflict(ctx, diary.ID)
+		}
+		if errors.Is(err, apperror.ErrDiaryNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrDiaryUpdateInternal
 	}
 
+	return s.GetDiaryByID(ctx, diary.ID)
+}
+
+// validateDiaryCategory 함수는 일기에 지정할 카테고리가 요청한 사용자의 카테고리인지 확인합니다. nil이면 카테고리 없음입니다.
+func (s *diaryService) validateDiaryCategory(ctx context.Context, categoryID *int64, creatorID int64) (int, error) {
+	if categoryID == nil {
+		return http.StatusOK, nil
+	}
+	if _, err := s.categoryRepository.GetCategoryByID(ctx, *categoryID, creatorID); err != nil {
+		if errors.Is(err, apperror.ErrCategoryNotFound) {
+			return http.StatusBadRequest, apperror.ErrDiaryCategoryNotFound
+		}
+		return http.StatusInternalServerError, apperror.ErrGetFailedInternalServerError
+	}
 	return http.StatusOK, nil
 }
 
+// diaryVersionConflict 함수는 버전 충돌 시 클라이언트가 비교할 수 있도록 서버의 현재 일기를 412 상태와 함께 반환합니다.
+func (s *diaryService) diaryVersionConflict(ctx context.Context, diaryID int64) (*model.Diary, int, error) {
+	current, status, err := s.GetDiaryByID(ctx, diaryID)
+	if err != nil {
+		return nil, status, err
+	}
+	return current, http.StatusPreconditionFailed, apperror.ErrDiaryVersionConflict
+}
+
 // UploadDiaryImage 함수는 다이어리 이미지를 업로드하고 저장된 경로를 반환합니다.
 func (s *diaryService) UploadDiaryImage(ctx context.Context, files []*multipart.FileHeader, diaryID int64, creatorID int64) ([]*model.DiaryImage, int, error) {
 	diary := &model.Diary{ID: diaryID}
@@ -153,3 +349,575 @@ func (s *diaryService) UploadDiaryImage(ctx context.Context, files []*multipart.
 	}
 	return diaryImages, http.StatusOK, nil
 }
+
+// SearchDiaries 함수는 제목과 본문에서 검색어를 찾아 관련도 순으로 반환합니다.
+// 각 결과에는 검색어가 강조된 제목과 본문 스니펫이 포함됩니다.
+func (s *diaryService) SearchDiaries(ctx context.Context, creatorID int64, searchDTO dto.SearchDiariesDTO) (*dto.SearchDiariesResponseDTO, int, error) {
+	location, status, err := s.getUserLocation(ctx, creatorID)
+	if err != nil {
+		return nil, status, err
+	}
+	searchDTO.Location = location
+
+	if err := searchDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if status, err := s.validateSearchMoods(ctx, searchDTO.Search); err != nil {
+		return nil, status, err
+	}
+
+	results, total, err := s.diaryRepository.SearchDiaries(ctx, creatorID, searchDTO)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrDiarySearchInternal
+	}
+
+	terms := searchDTO.Search.TextTerms()
+	for _, result := range results {
+		result.TitleHighlight = utils.HighlightTerms(result.Title, terms)
+		result.Snippet = utils.HighlightSnippet(result.Content, terms, dto.DIARY_SEARCH_SNIPPET_RADIUS)
+	}
+
+	if searchDTO.Page == 1 {
+		s.recordRecentSearchTerms(ctx, creatorID, searchDTO.Search)
+	}
+
+	return &dto.SearchDiariesResponseDTO{
+		Results: results,
+		Total:   total,
+		Page:    searchDTO.Page,
+		Limit:   searchDTO.Limit,
+	}, http.StatusOK, nil
+}
+
+// recordRecentSearchTerms 함수는 검색식의 일반 검색어를 자동 완성에 사용할 최근 검색어로 기록합니다.
+// 기록에 실패해도 검색 결과에는 영향을 주지 않도록 오류는 로그로만 남깁니다.
+func (s *diaryService) recordRecentSearchTerms(ctx context.Context, userID int64, search *searchquery.Query) {
+	terms := search.TextTerms()
+	if len(terms) == 0 {
+		return
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err == nil {
+		err = userRedisClient.AddRecentSearchTerms(ctx, userID, terms, RECENT_SEARCH_TERMS_MAX, RECENT_SEARCH_TERMS_EXPIRY)
+	}
+	if err != nil {
+		log.Printf("Failed to record recent search terms for user %d: %v", userID, err)
+	}
+}
+
+// SuggestSearch 함수는 검색식 끝에서 입력 중인 토큰에 대한 자동 완성 항목을 추천합니다.
+// 연산자 이름을 입력 중이면 연산자와 최근 검색어를, 연산자 값을 입력 중이면 카테고리 이름이나 사용할 수 있는 값을 추천합니다.
+func (s *diaryService) SuggestSearch(ctx context.Context, creatorID int64, input string) (*dto.DiarySearchSuggestionsResponseDTO, int, error) {
+	if utf8.RuneCountInString(input) > dto.DIARY_SEARCH_QUERY_MAX_LENGTH {
+		return nil, http.StatusBadRequest, apperror.ErrDiarySearchQueryTooLong
+	}
+
+	partial := searchquery.LastPartial(input)
+	res := &dto.DiarySearchSuggestionsResponseDTO{
+		ReplaceFrom: partial.Pos,
+		ReplaceTo:   partial.End,
+		Suggestions: []dto.DiarySearchSuggestionDTO{},
+	}
+
+	prefix := ""
+	if partial.Negated {
+		prefix = "-"
+	}
+	lowerValue := strings.ToLower(partial.Value)
+	add := func(suggestionType string, text string, description string) {
+		if len(res.Suggestions) < dto.DIARY_SEARCH_SUGGESTION_LIMIT {
+			res.Suggestions = append(res.Suggestions, dto.DiarySearchSuggestionDTO{Type: suggestionType, Text: prefix + text, Description: description})
+		}
+	}
+
+	if !partial.HasOperator {
+		for _, operator := range searchquery.Operators {
+			if strings.HasPrefix(operator.Name, lowerValue) {
+				add(SEARCH_SUGGESTION_OPERATOR, operator.Name+":", operator.Description)
+			}
+		}
+
+		userRedisClient, err := redis.GetUserRedis(ctx)
+		if err != nil {
+			return nil, http.StatusInternalServerError, apperror.ErrSearchQueryInternal
+		}
+		recentTerms, err := userRedisClient.ListRecentSearchTerms(ctx, creatorID, RECENT_SEARCH_TERMS_MAX)
+		if err != nil {
+			return nil, http.StatusInternalServerError, apperror.ErrSearchQueryInternal
+		}
+		for _, term := range recentTerms {
+			if strings.HasPrefix(strings.ToLower(term), lowerValue) {
+				add(SEARCH_SUGGESTION_RECENT, quoteSearchValue(term), "")
+			}
+		}
+
+		return res, http.StatusOK, nil
+	}
+
+	operator, ok := searchquery.LookupOperator(partial.Operator)
+	if !ok {
+		return res, http.StatusOK, nil
+	}
+
+	switch operator.Name {
+	case searchquery.OPERATOR_CATEGORY:
+		names, err := s.categoryRepository.FindCategoryNamesByPrefix(ctx, creatorID, partial.Value, dto.DIARY_SEARCH_SUGGESTION_LIMIT)
+		if err != nil {
+			return nil, http.StatusInternalServerError, apperror.ErrSearchQueryInternal
+		}
+		for _, name := range names {
+			add(SEARCH_SUGGESTION_CATEGORY, operator.Name+":"+quoteSearchValue(name), "")
+		}
+	case searchquery.OPERATOR_TAG:
+		names, err := s.tagRepository.FindTagNamesByPrefix(ctx, creatorID, strings.TrimLeft(partial.Value, "#"), dto.DIARY_SEARCH_SUGGESTION_LIMIT)
+		if err != nil {
+			return nil, http.StatusInternalServerError, apperror.ErrSearchQueryInternal
+		}
+		for _, name := range names {
+			add(SEARCH_SUGGESTION_TAG, operator.Name+":"+quoteSearchValue(name), "")
+		}
+	case searchquery.OPERATOR_MOOD:
+		moods, err := s.diaryRepository.ListMoods(ctx)
+		if err != nil {
+			return nil, http.StatusInternalServerError, apperror.ErrSearchQueryInternal
+		}
+		for _, mood := range moods {
+			if strings.HasPrefix(mood.Code, lowerValue) {
+				add(SEARCH_SUGGESTION_MOOD, operator.Name+":"+mood.Code, mood.Label)
+			}
+		}
+	case searchquery.OPERATOR_BEFORE, searchquery.OPERATOR_AFTER:
+		today := time.Now().Format(searchquery.DATE_LAYOUT)
+		if strings.HasPrefix(today, partial.Value) {
+			add(SEARCH_SUGGESTION_VALUE, operator.Name+":"+today, operator.Description)
+		}
+	default:
+		for _, value := range operator.Values {
+			if strings.HasPrefix(value, lowerValue) {
+				add(SEARCH_SUGGESTION_VALUE, operator.Name+":"+value, operator.Description)
+			}
+		}
+	}
+
+	return res, http.StatusOK, nil
+}
+
+// quoteSearchValue 함수는 공백이 포함된 값을 검색식에서 하나의 값으로 해석되도록 따옴표로 감쌉니다.
+func quoteSearchValue(value string) string {
+	if strings.ContainsFunc(value, unicode.IsSpace) {
+		return `"` + value + `"`
+	}
+	return value
+}
+
+// GetDiaryCalendar 함수는 한 달의 날짜별 일기 수, 첫 일기 제목, 이미지 포함 여부를 사용자 시간대 기준으로 반환합니다.
+// 일기가 없는 날짜도 포함하여 한 번의 요청으로 월간 캘린더를 그릴 수 있도록 합니다.
+func (s *diaryService) GetDiaryCalendar(ctx context.Context, creatorID int64, calendarDTO dto.DiaryCalendarDTO) (*dto.DiaryCalendarResponseDTO, int, error) {
+	location, status, err := s.getUserLocation(ctx, creatorID)
+	if err != nil {
+		return nil, status, err
+	}
+	calendarDTO.Location = location
+
+	if err := calendarDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	entries, err := s.diaryRepository.GetDiaryCalendar(ctx, creatorID, calendarDTO)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrDiaryCalendarInternal
+	}
+
+	entriesByDate := make(map[string]*model.DiaryCalendarDay, len(entries))
+	for _, entry := range entries {
+		entriesByDate[entry.Date] = entry
+	}
+
+	start, end := calendarDTO.Range()
+	days := []*model.DiaryCalendarDay{}
+	for date := start; date.Before(end); date = date.AddDate(0, 0, 1) {
+		key := date.Format(searchquery.DATE_LAYOUT)
+		if entry, ok := entriesByDate[key]; ok {
+			days = append(days, entry)
+			continue
+		}
+		days = append(days, &model.DiaryCalendarDay{Date: key})
+	}
+
+	return &dto.DiaryCalendarResponseDTO{
+		Year:     calendarDTO.Year,
+		Month:    calendarDTO.Month,
+		Timezone: location.String(),
+		Days:     days,
+	}, http.StatusOK, nil
+}
+
+// validateMoodCodes 함수는 감정 코드가 모두 감정 목록에 있는지 확인합니다. 확인할 코드가 없으면 감정 목록을 조회하지 않습니다.
+func (s *diaryService) validateMoodCodes(ctx context.Context, codes []string) (int, error) {
+	if len(codes) == 0 {
+		return http.StatusOK, nil
+	}
+
+	known, err := s.moodCodeSet(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrDiaryMoodInternal
+	}
+	for _, code := range codes {
+		if !known[code] {
+			return http.StatusBadRequest, apperror.ErrDiaryInvalidMood
+		}
+	}
+	return http.StatusOK, nil
+}
+
+// validateSearchMoods 함수는 검색식의 mood 연산자 값이 감정 목록에 있는지 확인합니다.
+// 없는 감정이면 입력창에서 오류 구간을 표시할 수 있도록 해당 검색어 위치가 포함된 *searchquery.ParseError를 반환합니다.
+func (s *diaryService) validateSearchMoods(ctx context.Context, search *searchquery.Query) (int, error) {
+	if search.IsEmpty() {
+		return http.StatusOK, nil
+	}
+
+	var known map[string]bool
+	for _, term := range search.Terms {
+		if term.Operator != searchquery.OPERATOR_MOOD {
+			continue
+		}
+		if known == nil {
+			var err error
+			if known, err = s.moodCodeSet(ctx); err != nil {
+				return http.StatusInternalServerError, apperror.ErrDiaryMoodInternal
+			}
+		}
+		if !known[term.Value] {
+			return http.StatusBadRequest, term.Error(apperror.ErrSearchQueryUnknownMood)
+		}
+	}
+	return http.StatusOK, nil
+}
+
+// moodCodeSet 함수는 감정 목록의 코드 집합을 반환합니다.
+func (s *diaryService) moodCodeSet(ctx context.Context) (map[string]bool, error) {
+	moods, err := s.diaryRepository.ListMoods(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(moods))
+	for _, mood := range moods {
+		known[mood.Code] = true
+	}
+	return known, nil
+}
+
+// ListMoods 함수는 일기에 지정할 수 있는 감정 목록을 표시 순서대로 반환합니다.
+func (s *diaryService) ListMoods(ctx context.Context) ([]*model.Mood, int, error) {
+	moods, err := s.diaryRepository.ListMoods(ctx)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrDiaryMoodInternal
+	}
+	return moods, http.StatusOK, nil
+}
+
+// GetMoodStats 함수는 사용자 시간대 기준 기간(일, 주, 월)별 감정 분포와 평균 강도, 조회 구간 전체의 감정별 합계를 반환합니다.
+func (s *diaryService) GetMoodStats(ctx context.Context, creatorID int64, statsDTO dto.MoodStatsDTO) (*dto.MoodStatsResponseDTO, int, error) {
+	location, status, err := s.getUserLocation(ctx, creatorID)
+	if err != nil {
+		return nil, status, err
+	}
+	statsDTO.Location = location
+
+	if err := statsDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	periods, err := s.diaryRepository.GetMoodStats(ctx, creatorID, statsDTO)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrDiaryMoodInternal
+	}
+
+	totalsByMood := map[string]*model.MoodCount{}
+	totals := []*model.MoodCount{}
+	for _, period := range periods {
+		for _, count := range period.Moods {
+			count.AverageIntensity = averageMoodIntensity(count)
+
+			total, ok := totalsByMood[count.Mood]
+			if !ok {
+				total = &model.MoodCount{Mood: count.Mood}
+				totalsByMood[count.Mood] = total
+				totals = append(totals, total)
+			}
+			total.Count += count.Count
+			total.IntensitySum += count.IntensitySum
+			total.IntensityCount += count.IntensityCount
+		}
+	}
+	for _, total := range totals {
+		total.AverageIntensity = averageMoodIntensity(total)
+	}
+	sort.SliceStable(totals, func(i, j int) bool {
+		return totals[i].Count > totals[j].Count
+	})
+
+	return &dto.MoodStatsResponseDTO{
+		Interval: statsDTO.Interval,
+		Timezone: location.String(),
+		From:     statsDTO.From,
+		To:       statsDTO.To,
+		Periods:  periods,
+		Totals:   totals,
+	}, http.StatusOK, nil
+}
+
+// averageMoodIntensity 함수는 강도를 지정한 일기의 평균 강도를 소수점 둘째 자리까지 계산합니다. 강도를 지정한 일기가 없으면 nil을 반환합니다.
+func averageMoodIntensity(count *model.MoodCount) *float64 {
+	if count.IntensityCount == 0 {
+		return nil
+	}
+	average := math.Round(float64(count.IntensitySum)/float64(count.IntensityCount)*100) / 100
+	return &average
+}
+
+// getOwnedDiary 함수는 일기를 조회하고 요청한 사용자가 작성자인지 확인합니다.
+func (s *diaryService) getOwnedDiary(ctx context.Context, diaryID int64, userID int64) (*model.Diary, int, error) {
+	diary := &model.Diary{ID: diaryID}
+	if err := s.diaryRepository.GetDiaryByID(ctx, diary); err != nil {
+		if errors.Is(err, apperror.ErrDiaryNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrDiaryGetInternal
+	}
+	if diary.CreatorID != userID {
+		return nil, http.StatusForbidden, apperror.ErrDiaryRevisionForbidden
+	}
+	return diary, http.StatusOK, nil
+}
+
+// getDiaryRevisions 함수는 사용자의 보관 개수 설정만큼 일기의 수정 기록을 최신순으로 조회합니다.
+func (s *diaryService) getDiaryRevisions(ctx context.Context, diaryID int64, userID int64) ([]*model.DiaryRevision, int, int, error) {
+	user, err := s.userRepository.FindUserByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, 0, http.StatusNotFound, err
+		}
+		return nil, 0, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	revisions, err := s.diaryRepository.GetDiaryRevisions(ctx, diaryID, user.DiaryRevisionLimit)
+	if err != nil {
+		return nil, 0, http.StatusInternalServerError, apperror.ErrDiaryRevisionInternal
+	}
+	if len(revisions) > 0 {
+		revisions[0].IsCurrent = true
+	}
+	return revisions, user.DiaryRevisionLimit, http.StatusOK, nil
+}
+
+// ListDiaryRevisions 함수는 일기의 수정 기록 목록을 최신순으로 반환합니다. 각 항목에는 내용이 포함되지 않습니다.
+func (s *diaryService) ListDiaryRevisions(ctx context.Context, diaryID int64, userID int64) (*dto.DiaryRevisionsResponseDTO, int, error) {
+	if _, status, err := s.getOwnedDiary(ctx, diaryID, userID); err != nil {
+		return nil, status, err
+	}
+
+	revisions, limit, status, err := s.getDiaryRevisions(ctx, diaryID, userID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	return &dto.DiaryRevisionsResponseDTO{
+		Revisions: revisions,
+		Limit:     limit,
+	}, http.StatusOK, nil
+}
+
+// GetDiaryRevision 함수는 일기의 수정 기록 하나를 내용과 함께 반환합니다.
+func (s *diaryService) GetDiaryRevision(ctx context.Context, diaryID int64, revisionNumber int, userID int64) (*model.DiaryRevision, int, error) {
+	if revisionNumber <= 0 {
+		return nil, http.StatusBadRequest, apperror.ErrDiaryRevisionInvalidNumber
+	}
+	if _, status, err := s.getOwnedDiary(ctx, diaryID, userID); err != nil {
+		return nil, status, err
+	}
+
+	revision, err := s.diaryRepository.GetDiaryRevision(ctx, diaryID, revisionNumber)
+	if err != nil {
+		if errors.Is(err, apperror.ErrDiaryRevisionNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrDiaryRevisionInternal
+	}
+	return revision, http.StatusOK, nil
+}
+
+// DiffDiaryRevisions 함수는 두 수정 기록의 제목과 내용을 비교합니다.
+// 비교할 수정 기록을 생략하면 최신 수정 기록과 그 바로 이전의 수정 기록을 비교합니다.
+func (s *diaryService) DiffDiaryRevisions(ctx context.Context, diaryID int64, userID int64, diffDTO dto.DiaryRevisionDiffDTO) (*dto.DiaryRevisionDiffResponseDTO, int, error) {
+	if err := diffDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	if _, status, err := s.getOwnedDiary(ctx, diaryID, userID); err != nil {
+		return nil, status, err
+	}
+
+	if diffDTO.From == 0 || diffDTO.To == 0 {
+		revisions, _, status, err := s.getDiaryRevisions(ctx, diaryID, userID)
+		if err != nil {
+			return nil, status, err
+		}
+		if diffDTO.To == 0 && len(revisions) > 0 {
+			diffDTO.To = revisions[0].Revision
+		}
+		if diffDTO.From == 0 {
+			// 수정 기록은 최신순이므로 To보다 작은 첫 번째 번호가 바로 이전의 수정 기록
+			for _, revision := range revisions {
+				if revision.Revision < diffDTO.To {
+					diffDTO.From = revision.Revision
+					break
+				}
+			}
+			if diffDTO.From == 0 {
+				return nil, http.StatusBadRequest, apperror.ErrDiaryRevisionNoPrevious
+			}
+		}
+	}
+
+	from, status, err := s.GetDiaryRevision(ctx, diaryID, diffDTO.From, userID)
+	if err != nil {
+		return nil, status, err
+	}
+	to, status, err := s.GetDiaryRevision(ctx, diaryID, diffDTO.To, userID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	content := utils.DiffLines(from.Content, to.Content)
+	if diffDTO.Mode == dto.DIARY_REVISION_DIFF_MODE_WORD {
+		content = utils.DiffWords(from.Content, to.Content)
+	}
+
+	return &dto.DiaryRevisionDiffResponseDTO{
+		From:    from.Revision,
+		To:      to.Revision,
+		Mode:    diffDTO.Mode,
+		Title:   utils.DiffWords(from.Title, to.Title),
+		Content: content,
+	}, http.StatusOK, nil
+}
+
+// RestoreDiaryRevision 함수는 이전 수정 기록의 제목과 내용으로 일기를 되돌립니다.
+// 기존 수정 기록은 그대로 두고 복원한 내용을 새 수정 기록으로 저장하며, 감정과 태그는 현재 값을 유지합니다.
+func (s *diaryService) RestoreDiaryRevision(ctx context.Context, diaryID int64, revisionNumber int, userID int64) (*model.Diary, int, error) {
+	revision, status, err := s.GetDiaryRevision(ctx, diaryID, revisionNumber, userID)
+	if err != nil {
+		return nil, status, err
+	}
+	if revision.IsCurrent {
+		return nil, http.StatusConflict, apperror.ErrDiaryRevisionAlreadyCurrent
+	}
+
+	diary, status, err := s.getOwnedDiary(ctx, diaryID, userID)
+	if err != nil {
+		return nil, status, err
+	}
+	diary.Title, diary.Content = revision.Title, revision.Content
+
+	// 조회 이후 다른 요청이 먼저 수정했다면 복원하지 않음
+	if err := s.diaryRepository.UpdateDiary(ctx, diary, &revision.Revision); err != nil {
+		if errors.Is(err, apperror.ErrDiaryVersionConflict) {
+			return nil, http.StatusConflict, err
+		}
+		if errors.Is(err, apperror.ErrDiaryNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrDiaryUpdateInternal
+	}
+
+	return s.GetDiaryByID(ctx, diaryID)
+}
+
+// GetTrashedDiaries 함수는 휴지통에 있는 일기 목록을 최근 삭제한 순서대로 반환합니다.
+func (s *diaryService) GetTrashedDiaries(ctx context.Context, creatorID int64, page dto.CursorPageDTO) (*dto.GetTrashedDiariesResponseDTO, int, error) {
+	if err := page.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	diaries, err := s.diaryRepository.GetTrashedDiaries(ctx, creatorID, page)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrDiaryTrashInternal
+	}
+
+	res := &dto.GetTrashedDiariesResponseDTO{
+		RetentionDays: int(config.GetConfig().DiaryTrashRetention.Hours() / 24),
+	}
+	res.Diaries, res.CursorPageInfoDTO = dto.NewCursorPage(diaries, page, func(diary model.Diary) dto.PageCursor {
+		deletedAt := ""
+		if diary.DeletedAt != nil {
+			deletedAt = *diary.DeletedAt
+		}
+		return newPageCursor(deletedAt, diary.ID)
+	})
+
+	if page.IncludeTotal {
+		total, err := s.diaryRepository.CountTrashedDiaries(ctx, creatorID)
+		if err != nil {
+			return nil, http.StatusInternalServerError, apperror.ErrDiaryTrashInternal
+		}
+		res.Total = &total
+	}
+
+	return res, http.StatusOK, nil
+}
+
+// RestoreDiary 함수는 휴지통에 있는 일기를 되살리고 복원된 일기를 반환합니다.
+func (s *diaryService) RestoreDiary(ctx context.Context, diaryID int64, creatorID int64) (*model.Diary, int, error) {
+	if diaryID <= 0 {
+		return nil, http.StatusBadRequest, apperror.ErrDiaryNotFoundInTrash
+	}
+	if err := s.diaryRepository.RestoreDiary(ctx, diaryID, creatorID); err != nil {
+		if errors.Is(err, apperror.ErrDiaryNotFoundInTrash) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrDiaryRestoreInternal
+	}
+
+	return s.GetDiaryByID(ctx, diaryID)
+}
+
+// PurgeDiary 함수는 휴지통에 있는 일기를 이미지 파일과 함께 영구 삭제합니다.
+func (s *diaryService) PurgeDiary(ctx context.Context, diaryID int64, creatorID int64) (int, error) {
+	if diaryID <= 0 {
+		return http.StatusBadRequest, apperror.ErrDiaryNotFoundInTrash
+	}
+
+	filePaths, err := s.diaryRepository.PurgeDiary(ctx, diaryID, creatorID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrDiaryNotFoundInTrash) {
+			return http.StatusNotFound, err
+		}
+		return http.StatusInternalServerError, apperror.ErrDiaryPurgeInternal
+	}
+
+	// DB 삭제가 확정된 이후의 파일 정리는 실패해도 로그만 남김
+	if err := utils.RemoveFiles(filePaths); err != nil {
+		log.Printf("Failed to remove image files of purged diary %d: %v", diaryID, err)
+	}
+	return http.StatusOK, nil
+}
+
+// PurgeExpiredDiaries 함수는 휴지통 보관 기간이 지난 일기를 이미지 파일과 함께 영구 삭제합니다.
+func (s *diaryService) PurgeExpiredDiaries(ctx context.Context) error {
+	retention := config.GetConfig().DiaryTrashRetention
+	count, filePaths, err := s.diaryRepository.PurgeExpiredDiaries(ctx, int64(retention.Seconds()))
+	if err != nil {
+		return err
+	}
+
+	if err := utils.RemoveFiles(filePaths); err != nil {
+		log.Printf("Failed to remove image files of purged diaries: %v", err)
+	}
+	if count > 0 {
+		log.Printf("Purged %d diaries from trash", count)
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jhphon0730/dairify/internal/auth"
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/redis"
+	"github.com/jhphon0730/dairify/internal/repository"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+// MFAService 인터페이스는 2단계 인증 등록 및 관리 관련 서비스의 메서드를 정의합니다.
+type MFAService interface {
+	Status(ctx context.Context, userID int64) (*dto.MFAStatusResponseDTO, int, error)
+	SetupTOTP(ctx context.Context, userID int64) (*dto.MFATOTPSetupResponseDTO, int, error)
+	ConfirmTOTP(ctx context.Context, userID int64, confirmDTO dto.MFATOTPConfirmDTO) ([]string, int, error)
+	DisableTOTP(ctx context.Context, userID int64, disableDTO dto.MFATOTPDisableDTO) (int, error)
+	RegenerateRecoveryCodes(ctx context.Context, userID int64, regenerateDTO dto.MFARecoveryCodesRegenerateDTO) ([]string, int, error)
+}
+
+// mfaService 구조체는 MFAService 인터페이스를 구현합니다.
+type mfaService struct {
+	userRepository repository.UserRepository
+	mfaRepository  repository.MFARepository
+}
+
+// NewMFAService 함수는 MFAService 인터페이스의 구현체를 반환합니다.
+func NewMFAService(userRepository repository.UserRepository, mfaRepository repository.MFARepository) MFAService {
+	return &mfaService{
+		userRepository: userRepository,
+		mfaRepository:  mfaRepository,
+	}
+}
+
+// Status 함수는 사용자의 2단계 인증 활성화 여부와 남은 복구 코드 개수를 반환합니다.
+func (s *mfaService) Status(ctx context.Context, userID int64) (*dto.MFAStatusResponseDTO, int, error) {
+	user, status, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	res := &dto.MFAStatusResponseDTO{
+		TOTPEnabled: user.IsTOTPEnabled(),
+	}
+	if res.TOTPEnabled {
+		remaining, err := s.mfaRepository.CountRemainingRecoveryCodes(ctx, userID)
+		if err != nil {
+			return nil, http.StatusInternalServerError, apperror.ErrMFAInternalServerError
+		}
+		res.RemainingRecoveryCodes = remaining
+	}
+
+	return res, http.StatusOK, nil
+}
+
+// SetupTOTP 함수는 새 TOTP 비밀 키를 발급하고 인증 앱 등록용 URI를 반환합니다.
+// ConfirmTOTP로 첫 코드를 확인하기 전까지는 로그인에 2단계 인증이 적용되지 않습니다.
+func (s *mfaService) SetupTOTP(ctx context.Context, userID int64) (*dto.MFATOTPSetupResponseDTO, int, error) {
+	user, status, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, status, err
+	}
+	if user.IsTOTPEnabled() {
+		return nil, http.StatusConflict, apperror.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrMFAInternalServerError
+	}
+	if err := s.mfaRepository.SetTOTPSecret(ctx, userID, secret); err != nil {
+		if errors.Is(err, apperror.ErrMFAAlreadyEnabled) {
+			return nil, http.StatusConflict, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrMFAInternalServerError
+	}
+
+	return &dto.MFATOTPSetupResponseDTO{
+		Secret:          secret,
+		ProvisioningURI: auth.TOTPProvisioningURI(user.Email, secret),
+	}, http.StatusOK, nil
+}
+
+// ConfirmTOTP 함수는 인증 앱의 첫 코드를 확인하여 TOTP를 활성화하고 복구 코드를 발급합니다.
+func (s *mfaService) ConfirmTOTP(ctx context.Context, userID int64, confirmDTO dto.MFATOTPConfirmDTO) ([]string, int, error) {
+	if err := confirmDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	user, status, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, status, err
+	}
+	if user.IsTOTPEnabled() {
+		return nil, http.StatusConflict, apperror.ErrMFAAlreadyEnabled
+	}
+	if user.TOTPSecret == nil {
+		return nil, http.StatusBadRequest, apperror.ErrMFASetupNotStarted
+	}
+
+	if status, err := verifyTOTPCode(ctx, user.ID, *user.TOTPSecret, confirmDTO.Code); err != nil {
+		return nil, status, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrMFAInternalServerError
+	}
+	if err := s.mfaRepository.EnableTOTP(ctx, userID, hashes); err != nil {
+		if errors.Is(err, apperror.ErrMFAAlreadyEnabled) {
+			return nil, http.StatusConflict, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrMFAInternalServerError
+	}
+
+	return codes, http.StatusOK, nil
+}
+
+// DisableTOTP 함수는 비밀번호와 두 번째 인증 수단을 모두 확인한 뒤 TOTP를 해제합니다.
+func (s *mfaService) DisableTOTP(ctx context.Context, userID int64, disableDTO dto.MFATOTPDisableDTO) (int, error) {
+	if err := disableDTO.Validate(); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	user, status, err := s.findUser(ctx, userID)
+	if err != nil {
+		return status, err
+	}
+	if !user.IsTOTPEnabled() {
+		return http.StatusConflict, apperror.ErrMFANotEnabled
+	}
+
+	if err := utils.CompareHashAndPassword(user.Password, disableDTO.Password); err != nil {
+		return http.StatusUnauthorized, apperror.ErrMFAInvalidPassword
+	}
+	if status, err := verifySecondFactor(ctx, s.mfaRepository, user, disableDTO.Code, disableDTO.RecoveryCode); err != nil {
+		return status, err
+	}
+
+	if err := s.mfaRepository.DisableTOTP(ctx, userID); err != nil {
+		return http.StatusInternalServerError, apperror.ErrMFAInternalServerError
+	}
+
+	return http.StatusOK, nil
+}
+
+// RegenerateRecoveryCodes 함수는 인증 앱 코드를 확인한 뒤 기존 복구 코드를 폐기하고 새로 발급합니다.
+func (s *mfaService) RegenerateRecoveryCodes(ctx context.Context, userID int64, regenerateDTO dto.MFARecoveryCodesRegenerateDTO) ([]string, int, error) {
+	if err := regenerateDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	user, status, err := s.findUser(ctx, userID)
+	if err != nil {
+		return nil, status, err
+	}
+	if !user.IsTOTPEnabled() {
+		return nil, http.StatusConflict, apperror.ErrMFANotEnabled
+	}
+
+	if status, err := verifyTOTPCode(ctx, user.ID, *user.TOTPSecret, regenerateDTO.Code); err != nil {
+		return nil, status, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrMFAInternalServerError
+	}
+	if err := s.mfaRepository.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrMFAInternalServerError
+	}
+
+	return codes, http.StatusOK, nil
+}
+
+// findUser 함수는 사용자 ID로 사용자를 조회하고 에러에 맞는 상태 코드를 함께 반환합니다.
+func (s *mfaService) findUser(ctx context.Context, userID int64) (*model.User, int, error) {
+	user, err := s.userRepository.FindUserByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+	return user, http.StatusOK, nil
+}
+
+// verifySecondFactor 함수는 인증 앱 코드가 있으면 TOTP로, 없으면 복구 코드로 두 번째 인증 수단을 확인합니다.
+// 복구 코드는 확인과 동시에 사용 처리됩니다.
+func verifySecondFactor(ctx context.Context, mfaRepository repository.MFARepository, user *model.User, code string, recoveryCode string) (int, error) {
+	if strings.TrimSpace(code) != "" {
+		return verifyTOTPCode(ctx, user.ID, *user.TOTPSecret, code)
+	}
+
+	codeHash := utils.HashToken(utils.NormalizeRecoveryCode(recoveryCode))
+	if err := mfaRepository.UseRecoveryCode(ctx, user.ID, codeHash); err != nil {
+		if errors.Is(err, apperror.ErrMFAInvalidCode) {
+			return http.StatusUnauthorized, err
+		}
+		return http.StatusInternalServerError, apperror.ErrMFAInternalServerError
+	}
+	return http.StatusOK, nil
+}
+
+// verifyTOTPCode 함수는 TOTP 코드를 검증하고, 같은 주기의 코드가 다시 사용되지 않도록 Redis에 기록합니다.
+func verifyTOTPCode(ctx context.Context, userID int64, secret string, code string) (int, error) {
+	counter, ok := auth.ValidateTOTPCode(secret, code, time.Now())
+	if !ok {
+		return http.StatusUnauthorized, apperror.ErrMFAInvalidCode
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	// 허용 오차 범위 전체가 지날 때까지 사용 기록 유지
+	expiry := time.Duration(2*auth.TOTP_SKEW+1) * auth.TOTP_PERIOD * time.Second
+	acquired, err := userRedisClient.AcquireTOTPCounter(ctx, userID, counter, expiry)
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if !acquired {
+		return http.StatusUnauthorized, apperror.ErrMFACodeAlreadyUsed
+	}
+
+	return http.StatusOK, nil
+}
+
+// generateRecoveryCodes 함수는 설정된 개수만큼 복구 코드를 생성하고, 원문과 저장용 해시를 함께 반환합니다.
+func generateRecoveryCodes() ([]string, []string, error) {
+	count := config.GetConfig().MFARecoveryCodeCount
+	codes := make([]string, 0, count)
+	hashes := make([]string, 0, count)
+
+	for i := 0; i < count; i++ {
+		code, err := utils.GenerateRecoveryCode()
+		if err != nil {
+			return nil, nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, utils.HashToken(utils.NormalizeRecoveryCode(code)))
+	}
+
+	return codes, hashes, nil
+}
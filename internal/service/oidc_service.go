@@ -0,0 +1,380 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/oidc"
+	"github.com/jhphon0730/dairify/internal/redis"
+	"github.com/jhphon0730/dairify/internal/repository"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+const (
+	OIDC_USERNAME_MAX_LENGTH   = 40 // 자동 생성 아이디 기본 부분 최대 길이 (users.username은 50자)
+	OIDC_NICKNAME_MAX_LENGTH   = 50
+	OIDC_USERNAME_MAX_ATTEMPTS = 5 // 아이디가 겹칠 때 접미사를 바꿔 다시 시도하는 횟수
+)
+
+// OIDCService 인터페이스는 외부 로그인(OpenID Connect) 관련 서비스의 메서드를 정의합니다.
+type OIDCService interface {
+	StartSignin(ctx context.Context, providerName string) (string, int, error)
+	Signin(ctx context.Context, providerName string, callbackDTO dto.OIDCCallbackDTO) (*dto.UserSigninResponseDTO, int, error)
+	StartLink(ctx context.Context, userID int64, providerName string) (string, int, error)
+	Link(ctx context.Context, userID int64, providerName string, callbackDTO dto.OIDCCallbackDTO) (*model.UserIdentity, int, error)
+	ListIdentities(ctx context.Context, userID int64) (*dto.UserIdentitiesResponseDTO, int, error)
+	Unlink(ctx context.Context, userID int64, providerName string) (int, error)
+}
+
+// oidcService 구조체는 OIDCService 인터페이스를 구현합니다.
+type oidcService struct {
+	userRepository         repository.UserRepository
+	userIdentityRepository repository.UserIdentityRepository
+}
+
+// NewOIDCService 함수는 OIDCService 인터페이스의 구현체를 반환합니다.
+func NewOIDCService(userRepository repository.UserRepository, userIdentityRepository repository.UserIdentityRepository) OIDCService {
+	return &oidcService{
+		userRepository:         userRepository,
+		userIdentityRepository: userIdentityRepository,
+	}
+}
+
+// StartSignin 함수는 외부 계정 로그인을 시작하고 제공자의 인가 요청 주소를 반환합니다.
+func (s *oidcService) StartSignin(ctx context.Context, providerName string) (string, int, error) {
+	return startOIDCAuthorization(ctx, providerName, model.OIDC_PURPOSE_SIGNIN, 0)
+}
+
+// Signin 함수는 인가 코드를 확인하고 연결된 사용자로 로그인합니다.
+// 연결된 사용자가 없으면 외부 계정 정보로 새 사용자를 만들며, 토큰 발급은 SigninUser와 같은 절차를 따릅니다.
+func (s *oidcService) Signin(ctx context.Context, providerName string, callbackDTO dto.OIDCCallbackDTO) (*dto.UserSigninResponseDTO, int, error) {
+	providerName = strings.ToLower(providerName)
+	claims, status, err := finishOIDCAuthorization(ctx, providerName, model.OIDC_PURPOSE_SIGNIN, 0, callbackDTO)
+	if err != nil {
+		return nil, status, err
+	}
+
+	var user *model.User
+	identity, err := s.userIdentityRepository.FindIdentity(ctx, providerName, claims.Subject)
+	switch {
+	case err == nil:
+		user, err = s.userRepository.FindUserByUserID(ctx, identity.UserID)
+		if err != nil {
+			return nil, http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+		}
+	case errors.Is(err, apperror.ErrOIDCIdentityNotFound):
+		user, status, err = s.signupWithIdentity(ctx, providerName, claims)
+		if err != nil {
+			return nil, status, err
+		}
+	default:
+		return nil, http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+	}
+
+	session := &model.Session{
+		UserID:     user.ID,
+		DeviceName: callbackDTO.DeviceName,
+		IP:         callbackDTO.IP,
+		UserAgent:  callbackDTO.UserAgent,
+	}
+	return completeSignin(ctx, user, session, map[string]string{model.AUDIT_META_METHOD: model.AUDIT_SIGNIN_METHOD_OIDC, model.AUDIT_META_PROVIDER: providerName})
+}
+
+// StartLink 함수는 로그인한 사용자에게 외부 계정을 연결하기 위한 인가 요청 주소를 반환합니다.
+func (s *oidcService) StartLink(ctx context.Context, userID int64, providerName string) (string, int, error) {
+	return startOIDCAuthorization(ctx, providerName, model.OIDC_PURPOSE_LINK, userID)
+}
+
+// Link 함수는 인가 코드를 확인하고 외부 계정을 사용자에게 연결합니다.
+func (s *oidcService) Link(ctx context.Context, userID int64, providerName string, callbackDTO dto.OIDCCallbackDTO) (*model.UserIdentity, int, error) {
+	providerName = strings.ToLower(providerName)
+	claims, status, err := finishOIDCAuthorization(ctx, providerName, model.OIDC_PURPOSE_LINK, userID, callbackDTO)
+	if err != nil {
+		return nil, status, err
+	}
+
+	identity := &model.UserIdentity{
+		UserID:   userID,
+		Provider: providerName,
+		Subject:  claims.Subject,
+		Email:    strings.TrimSpace(claims.Email),
+	}
+	if err := s.userIdentityRepository.CreateIdentity(ctx, identity); err != nil {
+		if errors.Is(err, apperror.ErrOIDCIdentityAlreadyLinked) || errors.Is(err, apperror.ErrOIDCProviderAlreadyLinked) {
+			return nil, http.StatusConflict, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+	}
+
+	return identity, http.StatusCreated, nil
+}
+
+// ListIdentities 함수는 사용자에게 연결된 외부 계정 목록을 반환합니다.
+func (s *oidcService) ListIdentities(ctx context.Context, userID int64) (*dto.UserIdentitiesResponseDTO, int, error) {
+	user, err := s.userRepository.FindUserByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+
+	identities, err := s.userIdentityRepository.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+	}
+
+	return &dto.UserIdentitiesResponseDTO{
+		Identities:  identities,
+		HasPassword: user.HasPassword(),
+	}, http.StatusOK, nil
+}
+
+// Unlink 함수는 외부 계정 연결을 해제합니다. 비밀번호가 없는 사용자는 로그인 수단이 남지 않도록 마지막 외부 계정을 해제할 수 없습니다.
+func (s *oidcService) Unlink(ctx context.Context, userID int64, providerName string) (int, error) {
+	user, err := s.userRepository.FindUserByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return http.StatusNotFound, err
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	if !user.HasPassword() {
+		count, err := s.userIdentityRepository.CountIdentities(ctx, userID)
+		if err != nil {
+			return http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+		}
+		if count <= 1 {
+			return http.StatusConflict, apperror.ErrOIDCLastSigninMethod
+		}
+	}
+
+	if err := s.userIdentityRepository.DeleteIdentity(ctx, userID, strings.ToLower(providerName)); err != nil {
+		if errors.Is(err, apperror.ErrOIDCIdentityNotFound) {
+			return http.StatusNotFound, err
+		}
+		return http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+	}
+
+	return http.StatusOK, nil
+}
+
+// signupWithIdentity 함수는 외부 계정 정보로 새 사용자를 만들고 외부 계정을 연결합니다.
+// 같은 이메일의 사용자가 이미 있으면 계정 탈취를 막기 위해 자동으로 연결하지 않습니다.
+func (s *oidcService) signupWithIdentity(ctx context.Context, providerName string, claims *oidc.IDTokenClaims) (*model.User, int, error) {
+	email := strings.TrimSpace(claims.Email)
+	if email == "" {
+		return nil, http.StatusBadRequest, apperror.ErrOIDCEmailRequired
+	}
+
+	if _, err := s.userRepository.FindUserByEmail(ctx, email); err == nil {
+		return nil, http.StatusConflict, apperror.ErrOIDCEmailAlreadyRegistered
+	} else if !errors.Is(err, apperror.ErrUserNotFound) {
+		return nil, http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+	}
+
+	user := &model.User{
+		Nickname: oidcNickname(claims),
+		Email:    email,
+	}
+	if claims.IsEmailVerified() {
+		now := time.Now()
+		user.EmailVerifiedAt = &now
+	}
+	identity := &model.UserIdentity{
+		Provider: providerName,
+		Subject:  claims.Subject,
+		Email:    email,
+	}
+
+	base := oidcUsernameBase(claims)
+	for attempt := 0; attempt < OIDC_USERNAME_MAX_ATTEMPTS; attempt++ {
+		user.Username = base
+		if attempt > 0 {
+			suffix, err := randomUsernameSuffix()
+			if err != nil {
+				return nil, http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+			}
+			user.Username = base + "_" + suffix
+		}
+
+		err := s.userIdentityRepository.CreateUserWithIdentity(ctx, user, identity)
+		if err == nil {
+			break
+		}
+		if errors.Is(err, apperror.ErrUserSignupDuplicateUserName) && attempt < OIDC_USERNAME_MAX_ATTEMPTS-1 {
+			continue
+		}
+		if errors.Is(err, apperror.ErrUserSignupDuplicateEmail) {
+			return nil, http.StatusConflict, apperror.ErrOIDCEmailAlreadyRegistered
+		}
+		if errors.Is(err, apperror.ErrOIDCIdentityAlreadyLinked) {
+			return nil, http.StatusConflict, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+	}
+
+	// 제공자가 확인하지 않은 이메일은 직접 인증을 받도록 인증 메일 발송
+	if user.EmailVerifiedAt == nil && config.GetConfig().EMAIL_VERIFICATION != config.EMAIL_VERIFICATION_OFF {
+		if err := sendVerificationEmail(user); err != nil {
+			log.Printf("Failed to send verification email to user %d: %v", user.ID, err)
+		}
+	}
+
+	return user, http.StatusCreated, nil
+}
+
+// startOIDCAuthorization 함수는 state, nonce, PKCE code_verifier를 만들어 저장하고 제공자의 인가 요청 주소를 반환합니다.
+func startOIDCAuthorization(ctx context.Context, providerName string, purpose string, userID int64) (string, int, error) {
+	provider, err := oidc.GetProvider(providerName)
+	if err != nil {
+		return "", http.StatusNotFound, err
+	}
+
+	state, err := oidc.GenerateState()
+	if err != nil {
+		return "", http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+	}
+	nonce, err := oidc.GenerateState()
+	if err != nil {
+		return "", http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+	}
+	codeVerifier, err := oidc.GenerateCodeVerifier()
+	if err != nil {
+		return "", http.StatusInternalServerError, apperror.ErrOIDCInternalServerError
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, oidc.CodeChallengeS256(codeVerifier))
+	if err != nil {
+		return "", oidcErrorStatus(err), err
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	loginState := &model.OIDCLoginState{
+		Provider:     provider.Name(),
+		Purpose:      purpose,
+		UserID:       userID,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+	}
+	if err := userRedisClient.SetOIDCState(ctx, state, loginState, config.GetConfig().OIDCStateExpiry); err != nil {
+		return "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	return authURL, http.StatusOK, nil
+}
+
+// finishOIDCAuthorization 함수는 state를 확인하고 인가 코드를 교환하여 검증된 ID 토큰 클레임을 반환합니다.
+// state는 한 번만 사용할 수 있으며, 시작한 요청과 제공자, 목적, 사용자가 모두 같아야 합니다.
+func finishOIDCAuthorization(ctx context.Context, providerName string, purpose string, userID int64, callbackDTO dto.OIDCCallbackDTO) (*oidc.IDTokenClaims, int, error) {
+	if err := callbackDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	provider, err := oidc.GetProvider(providerName)
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	loginState, err := userRedisClient.ConsumeOIDCState(ctx, callbackDTO.State)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserRedisOIDCStateNotFound) {
+			return nil, http.StatusBadRequest, apperror.ErrOIDCInvalidState
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if loginState.Provider != provider.Name() || loginState.Purpose != purpose || loginState.UserID != userID {
+		return nil, http.StatusBadRequest, apperror.ErrOIDCInvalidState
+	}
+
+	rawIDToken, err := provider.Exchange(ctx, callbackDTO.Code, loginState.CodeVerifier)
+	if err != nil {
+		return nil, oidcErrorStatus(err), err
+	}
+
+	claims, err := provider.VerifyIDToken(ctx, rawIDToken, loginState.Nonce)
+	if err != nil {
+		return nil, oidcErrorStatus(err), err
+	}
+
+	return claims, http.StatusOK, nil
+}
+
+// oidcErrorStatus 함수는 외부 로그인 제공자 연동 에러에 맞는 상태 코드를 반환합니다.
+func oidcErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, apperror.ErrOIDCDiscoveryFailed):
+		return http.StatusBadGateway
+	case errors.Is(err, apperror.ErrOIDCTokenExchangeFailed),
+		errors.Is(err, apperror.ErrOIDCInvalidIDToken),
+		errors.Is(err, apperror.ErrOIDCUnknownKeyID):
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// oidcUsernameBase 함수는 외부 계정의 preferred_username 또는 이메일 앞부분으로 아이디 후보를 만듭니다.
+// 영문 소문자, 숫자, 밑줄만 남깁니다.
+func oidcUsernameBase(claims *oidc.IDTokenClaims) string {
+	candidate := claims.PreferredUsername
+	if candidate == "" {
+		candidate, _, _ = strings.Cut(claims.Email, "@")
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(candidate) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' {
+			b.WriteRune(r)
+		}
+		if b.Len() >= OIDC_USERNAME_MAX_LENGTH {
+			break
+		}
+	}
+
+	if b.Len() == 0 {
+		return "user"
+	}
+	return b.String()
+}
+
+// oidcNickname 함수는 외부 계정의 이름으로 닉네임을 만듭니다. 이름이 없으면 이메일 앞부분을 사용합니다.
+func oidcNickname(claims *oidc.IDTokenClaims) string {
+	nickname := strings.TrimSpace(claims.Name)
+	if nickname == "" {
+		nickname, _, _ = strings.Cut(claims.Email, "@")
+	}
+	for utf8.RuneCountInString(nickname) > OIDC_NICKNAME_MAX_LENGTH {
+		_, size := utf8.DecodeLastRuneInString(nickname)
+		nickname = nickname[:len(nickname)-size]
+	}
+	return nickname
+}
+
+// randomUsernameSuffix 함수는 아이디 중복을 피하기 위한 임의의 접미사를 생성합니다.
+func randomUsernameSuffix() (string, error) {
+	b := make([]byte, 3)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
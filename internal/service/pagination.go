@@ -0,0 +1,13 @@
+package service
+
+import (
+	"time"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+)
+
+// newPageCursor 함수는 조회된 항목의 생성 시각 문자열(RFC3339)과 ID로 페이지 커서를 만듭니다.
+func newPageCursor(createdAt string, id int64) dto.PageCursor {
+	parsed, _ := time.Parse(time.RFC3339Nano, createdAt)
+	return dto.PageCursor{CreatedAt: parsed, ID: id}
+}
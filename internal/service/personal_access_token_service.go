@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/auth"
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/repository"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+const PAT_MAX_ACTIVE_PER_USER = 50 // 사용자당 동시에 사용할 수 있는 토큰 최대 개수
+
+// PersonalAccessTokenService 인터페이스는 개인 액세스 토큰 관련 서비스의 메서드를 정의합니다.
+type PersonalAccessTokenService interface {
+	CreateToken(ctx context.Context, createTokenDTO dto.CreatePersonalAccessTokenDTO) (string, *model.PersonalAccessToken, int, error)
+	ListTokens(ctx context.Context, userID int64) ([]*model.PersonalAccessToken, int, error)
+	RevokeToken(ctx context.Context, userID int64, tokenID int64) (int, error)
+}
+
+// personalAccessTokenService 구조체는 PersonalAccessTokenService 인터페이스를 구현합니다.
+type personalAccessTokenService struct {
+	personalAccessTokenRepository repository.PersonalAccessTokenRepository
+}
+
+// NewPersonalAccessTokenService 함수는 PersonalAccessTokenService 인터페이스의 구현체를 반환합니다.
+func NewPersonalAccessTokenService(personalAccessTokenRepository repository.PersonalAccessTokenRepository) PersonalAccessTokenService {
+	return &personalAccessTokenService{
+		personalAccessTokenRepository: personalAccessTokenRepository,
+	}
+}
+
+// CreateToken 함수는 새 개인 액세스 토큰을 발급하고 토큰 원문을 반환합니다. 원문은 저장하지 않습니다.
+func (s *personalAccessTokenService) CreateToken(ctx context.Context, createTokenDTO dto.CreatePersonalAccessTokenDTO) (string, *model.PersonalAccessToken, int, error) {
+	if err := createTokenDTO.Validate(); err != nil {
+		return "", nil, http.StatusBadRequest, err
+	}
+
+	count, err := s.personalAccessTokenRepository.CountActiveTokens(ctx, createTokenDTO.UserID)
+	if err != nil {
+		return "", nil, http.StatusInternalServerError, apperror.ErrPersonalAccessTokenInternalServer
+	}
+	if count >= PAT_MAX_ACTIVE_PER_USER {
+		return "", nil, http.StatusConflict, apperror.ErrPersonalAccessTokenLimitExceeded
+	}
+
+	rawToken, tokenPrefix, tokenHash, err := auth.GeneratePersonalAccessToken()
+	if err != nil {
+		return "", nil, http.StatusInternalServerError, apperror.ErrPersonalAccessTokenInternalServer
+	}
+
+	token := createTokenDTO.ToModel()
+	token.TokenPrefix = tokenPrefix
+	token.TokenHash = tokenHash
+	if err := s.personalAccessTokenRepository.CreateToken(ctx, token); err != nil {
+		return "", nil, http.StatusInternalServerError, apperror.ErrPersonalAccessTokenInternalServer
+	}
+
+	return rawToken, token, http.StatusCreated, nil
+}
+
+// ListTokens 함수는 사용자의 개인 액세스 토큰 목록을 반환합니다.
+func (s *personalAccessTokenService) ListTokens(ctx context.Context, userID int64) ([]*model.PersonalAccessToken, int, error) {
+	tokens, err := s.personalAccessTokenRepository.ListTokens(ctx, userID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrPersonalAccessTokenInternalServer
+	}
+
+	return tokens, http.StatusOK, nil
+}
+
+// RevokeToken 함수는 사용자의 개인 액세스 토큰을 폐기합니다.
+func (s *personalAccessTokenService) RevokeToken(ctx context.Context, userID int64, tokenID int64) (int, error) {
+	if err := s.personalAccessTokenRepository.RevokeToken(ctx, userID, tokenID); err != nil {
+		if errors.Is(err, apperror.ErrPersonalAccessTokenNotFound) {
+			return http.StatusNotFound, err
+		}
+		return http.StatusInternalServerError, apperror.ErrPersonalAccessTokenInternalServer
+	}
+
+	return http.StatusOK, nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/redis"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+// MAX_LOCKOUT_LEVEL은 잠금 시간 계산 시 시프트 연산이 넘치지 않도록 제한하는 값입니다.
+const MAX_LOCKOUT_LEVEL = 20
+
+var (
+	dummyPasswordHashOnce sync.Once
+	dummyPasswordHash     string
+)
+
+// signinTarget 구조체는 로그인 실패를 집계할 범위와 식별자, 잠금 기준 횟수를 묶어 나타냅니다.
+type signinTarget struct {
+	scope       string
+	identifier  string
+	maxFailures int64
+}
+
+// signinTargets 함수는 로그인 요청에서 실패를 집계할 대상(아이디, IP) 목록을 만듭니다.
+func signinTargets(username string, ip string) []signinTarget {
+	cfg := config.GetConfig()
+
+	targets := []signinTarget{
+		{scope: redis.SIGNIN_SCOPE_USERNAME, identifier: normalizeSigninUsername(username), maxFailures: cfg.SigninMaxFailuresPerUsername},
+	}
+	if ip != "" {
+		targets = append(targets, signinTarget{scope: redis.SIGNIN_SCOPE_IP, identifier: ip, maxFailures: cfg.SigninMaxFailuresPerIP})
+	}
+	return targets
+}
+
+// normalizeSigninUsername 함수는 대소문자나 공백만 바꾼 시도가 별도로 집계되지 않도록 아이디를 정규화합니다.
+func normalizeSigninUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+// checkSigninLock 함수는 아이디 또는 IP가 잠겨 있는지 확인합니다.
+func (s *userService) checkSigninLock(ctx context.Context, username string, ip string) (int, error) {
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	for _, target := range signinTargets(username, ip) {
+		remaining, err := userRedisClient.GetSigninLock(ctx, target.scope, target.identifier)
+		if err != nil {
+			return http.StatusInternalServerError, apperror.ErrInternalServerError
+		}
+		if remaining > 0 {
+			return http.StatusTooManyRequests, apperror.ErrUserSigninLocked
+		}
+	}
+
+	return http.StatusOK, nil
+}
+
+// recordSigninFailure 함수는 아이디와 IP의 실패 횟수를 증가시키고, 기준을 넘으면 지수적으로 늘어나는 시간만큼 잠급니다.
+// userID는 존재하는 사용자일 때만 전달되며 잠금 기록에 함께 저장됩니다.
+func (s *userService) recordSigninFailure(ctx context.Context, username string, ip string, userID *int64) {
+	cfg := config.GetConfig()
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		log.Printf("Failed to record signin failure: %v", err)
+		return
+	}
+
+	for _, target := range signinTargets(username, ip) {
+		failures, err := userRedisClient.RecordSigninFailure(ctx, target.scope, target.identifier, cfg.SigninFailureWindow)
+		if err != nil {
+			log.Printf("Failed to record signin failure for %s: %v", target.scope, err)
+			continue
+		}
+		if failures < target.maxFailures {
+			continue
+		}
+
+		level, err := userRedisClient.IncrSigninLockoutLevel(ctx, target.scope, target.identifier, cfg.SigninLockoutLevelExpiry)
+		if err != nil {
+			log.Printf("Failed to increase signin lockout level for %s: %v", target.scope, err)
+			continue
+		}
+		duration := signinLockoutDuration(level)
+		if err := userRedisClient.LockSignin(ctx, target.scope, target.identifier, duration); err != nil {
+			log.Printf("Failed to lock signin for %s: %v", target.scope, err)
+			continue
+		}
+
+		lockout := &model.SigninLockout{
+			Scope:        target.scope,
+			Identifier:   target.identifier,
+			IP:           ip,
+			FailureCount: failures,
+			LockedUntil:  time.Now().Add(duration),
+		}
+		if target.scope == redis.SIGNIN_SCOPE_USERNAME {
+			lockout.UserID = userID
+		}
+		if err := s.signinLockoutRepository.CreateLockout(ctx, lockout); err != nil {
+			log.Printf("Failed to save signin lockout for %s: %v", target.scope, err)
+		}
+		log.Printf("Signin locked: scope=%s identifier=%q failures=%d duration=%s", target.scope, target.identifier, failures, duration)
+	}
+}
+
+// clearSigninFailures 함수는 로그인 성공 시 아이디의 실패 기록을 초기화합니다. 2단계 인증 사용자는 2단계 인증까지 성공해야 초기화합니다.
+// IP는 여러 사용자가 공유할 수 있으므로 한 번의 성공으로 초기화하지 않습니다.
+func (s *userService) clearSigninFailures(ctx context.Context, username string) {
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return
+	}
+	if err := userRedisClient.ClearSigninFailures(ctx, redis.SIGNIN_SCOPE_USERNAME, normalizeSigninUsername(username)); err != nil {
+		log.Printf("Failed to clear signin failures: %v", err)
+	}
+}
+
+// signinLockoutDuration 함수는 연속 잠금 횟수에 따라 두 배씩 늘어나는 잠금 시간을 최대값 이내로 계산합니다.
+func signinLockoutDuration(level int64) time.Duration {
+	cfg := config.GetConfig()
+	if level < 1 {
+		level = 1
+	}
+	if level > MAX_LOCKOUT_LEVEL {
+		return cfg.SigninLockoutMax
+	}
+
+	duration := cfg.SigninLockoutBase << (level - 1)
+	if duration > cfg.SigninLockoutMax {
+		return cfg.SigninLockoutMax
+	}
+	return duration
+}
+
+// compareDummyPassword 함수는 존재하지 않는 아이디로 로그인할 때도 비밀번호 검증과 비슷한 시간이 걸리도록
+// 더미 해시와 비교합니다. 응답 시간으로 아이디 존재 여부를 추측하지 못하게 합니다.
+func compareDummyPassword(password string) {
+	dummyPasswordHashOnce.Do(func() {
+		dummyPasswordHash, _ = utils.GenerateHashPassword("dairify-dummy-password")
+	})
+	if dummyPasswordHash != "" {
+		_ = utils.CompareHashAndPassword(dummyPasswordHash, password)
+	}
+}
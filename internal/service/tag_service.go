@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/internal/repository"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// TagService 인터페이스는 태그 관리 서비스의 메서드를 정의합니다.
+type TagService interface {
+	GetTagsByCreatorID(ctx context.Context, creatorID int64) (*dto.GetTagsResponseDTO, int, error)
+	UpdateTagName(ctx context.Context, updateTagDTO dto.UpdateTagDTO) (*model.Tag, int, error)
+	MergeTags(ctx context.Context, mergeTagDTO dto.MergeTagDTO) (*model.Tag, int, error)
+	DeleteTag(ctx context.Context, tagID int64, creatorID int64) (int, error)
+}
+
+// tagService 구조체는 TagService 인터페이스를 구현합니다.
+type tagService struct {
+	tagRepository repository.TagRepository
+}
+
+// NewTagService 함수는 TagService 인터페이스의 구현체를 반환합니다.
+func NewTagService(tagRepository repository.TagRepository) TagService {
+	return &tagService{
+		tagRepository: tagRepository,
+	}
+}
+
+// getTag 함수는 사용자의 태그를 조회하고 오류에 맞는 상태 코드를 반환합니다.
+func (s *tagService) getTag(ctx context.Context, tagID int64, creatorID int64) (*model.Tag, int, error) {
+	tag, err := s.tagRepository.GetTagByID(ctx, tagID, creatorID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrTagNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrTagGetInternal
+	}
+	return tag, http.StatusOK, nil
+}
+
+// GetTagsByCreatorID 함수는 사용자의 모든 태그와 태그별 일기 수를 일기 수가 많은 순으로 반환합니다.
+func (s *tagService) GetTagsByCreatorID(ctx context.Context, creatorID int64) (*dto.GetTagsResponseDTO, int, error) {
+	tags, err := s.tagRepository.GetTagsByCreatorID(ctx, creatorID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrTagGetInternal
+	}
+	return &dto.GetTagsResponseDTO{Tags: tags}, http.StatusOK, nil
+}
+
+// UpdateTagName 함수는 태그 이름을 변경합니다. 같은 이름의 태그가 이미 있으면 병합을 사용하도록 409를 반환합니다.
+func (s *tagService) UpdateTagName(ctx context.Context, updateTagDTO dto.UpdateTagDTO) (*model.Tag, int, error) {
+	if err := updateTagDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	tag, status, err := s.getTag(ctx, updateTagDTO.ID, updateTagDTO.CreatorID)
+	if err != nil {
+		return nil, status, err
+	}
+
+	tag.Name = updateTagDTO.Name
+	if err := s.tagRepository.UpdateTagName(ctx, tag); err != nil {
+		switch {
+		case errors.Is(err, apperror.ErrTagNameDuplicate):
+			return nil, http.StatusConflict, err
+		case errors.Is(err, apperror.ErrTagNotFound):
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrTagUpdateInternal
+	}
+
+	return tag, http.StatusOK, nil
+}
+
+// MergeTags 함수는 원본 태그가 지정된 일기를 모두 대상 태그로 옮기고 원본 태그를 삭제한 뒤 대상 태그를 반환합니다.
+func (s *tagService) MergeTags(ctx context.Context, mergeTagDTO dto.MergeTagDTO) (*model.Tag, int, error) {
+	if err := mergeTagDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	// 두 태그 모두 요청한 사용자의 태그인지 확인
+	if _, status, err := s.getTag(ctx, mergeTagDTO.SourceID, mergeTagDTO.CreatorID); err != nil {
+		return nil, status, err
+	}
+	if _, status, err := s.getTag(ctx, mergeTagDTO.TargetID, mergeTagDTO.CreatorID); err != nil {
+		return nil, status, err
+	}
+
+	if err := s.tagRepository.MergeTags(ctx, mergeTagDTO.SourceID, mergeTagDTO.TargetID, mergeTagDTO.CreatorID); err != nil {
+		if errors.Is(err, apperror.ErrTagNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrTagMergeInternal
+	}
+
+	return s.getTag(ctx, mergeTagDTO.TargetID, mergeTagDTO.CreatorID)
+}
+
+// DeleteTag 함수는 태그를 삭제하고 태그가 지정된 일기에서 태그를 해제합니다.
+func (s *tagService) DeleteTag(ctx context.Context, tagID int64, creatorID int64) (int, error) {
+	if tagID <= 0 {
+		return http.StatusBadRequest, apperror.ErrTagIDIsRequired
+	}
+
+	if err := s.tagRepository.DeleteTag(ctx, tagID, creatorID); err != nil {
+		if errors.Is(err, apperror.ErrTagNotFound) {
+			return http.StatusNotFound, err
+		}
+		return http.StatusInternalServerError, apperror.ErrTagDeleteInternal
+	}
+
+	return http.StatusOK, nil
+}
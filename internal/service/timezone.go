@@ -0,0 +1,23 @@
+package service
+
+import (
+	"time"
+
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/internal/model"
+	"github.com/jhphon0730/dairify/pkg/utils"
+)
+
+// userLocation 함수는 날짜 계산에 사용할 사용자의 시간대를 반환합니다.
+// 사용자가 시간대를 설정하지 않았거나 불러올 수 없으면 서버 기본 시간대(DEFAULT_TIMEZONE)를 사용합니다.
+func userLocation(user *model.User) *time.Location {
+	if user.Timezone != "" {
+		if location, err := utils.LoadTimezone(user.Timezone); err == nil {
+			return location
+		}
+	}
+	if location, err := utils.LoadTimezone(config.GetConfig().DEFAULT_TIMEZONE); err == nil {
+		return location
+	}
+	return time.UTC
+}
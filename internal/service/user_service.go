@@ -3,10 +3,19 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/jhphon0730/dairify/internal/audit"
 	"github.com/jhphon0730/dairify/internal/auth"
+	"github.com/jhphon0730/dairify/internal/config"
 	"github.com/jhphon0730/dairify/internal/dto"
+	"github.com/jhphon0730/dairify/internal/mailer"
 	"github.com/jhphon0730/dairify/internal/model"
 	"github.com/jhphon0730/dairify/internal/redis"
 	"github.com/jhphon0730/dairify/internal/repository"
@@ -17,20 +26,35 @@ import (
 // UserService 인터페이스는 사용자 관련 서비스의 메서드를 정의합니다.
 type UserService interface {
 	SignupUser(ctx context.Context, userSignupDTO dto.UserSignupDTO) (int64, int, error)
-	SigninUser(ctx context.Context, userSigninDTO dto.UserSigninDTO) (string, string, *model.User, int, error)
-	SignoutUser(ctx context.Context, userID int64) (int, error)
+	SigninUser(ctx context.Context, userSigninDTO dto.UserSigninDTO) (*dto.UserSigninResponseDTO, int, error)
+	SigninMFA(ctx context.Context, signinMFADTO dto.UserSigninMFADTO) (*dto.UserSigninResponseDTO, int, error)
+	SignoutUser(ctx context.Context, userID int64, sessionID string) (int, error)
+	SignoutOtherSessions(ctx context.Context, userID int64, sessionID string) (int, error)
+	GetSessions(ctx context.Context, userID int64, sessionID string) ([]*model.Session, int, error)
+	RevokeSession(ctx context.Context, userID int64, sessionID string) (int, error)
+	RefreshToken(ctx context.Context, userRefreshDTO dto.UserRefreshDTO) (string, string, int, error)
+	ChangePassword(ctx context.Context, userID int64, sessionID string, changePasswordDTO dto.UserChangePasswordDTO) (string, string, int, error)
+	RequestPasswordReset(ctx context.Context, resetRequestDTO dto.UserPasswordResetRequestDTO) (int, error)
+	ConfirmPasswordReset(ctx context.Context, resetConfirmDTO dto.UserPasswordResetConfirmDTO) (int, error)
 	Profile(ctx context.Context, userID int64) (*model.User, int, error)
+	UpdateProfile(ctx context.Context, userID int64, updateProfileDTO dto.UserUpdateProfileDTO) (*model.User, int, error)
+	VerifyEmail(ctx context.Context, verifyEmailDTO dto.UserVerifyEmailDTO) (int, error)
+	ResendVerificationEmail(ctx context.Context, resendDTO dto.UserResendVerificationDTO) (int, error)
 }
 
 // userService 구조체는 UserService 인터페이스를 구현합니다.
 type userService struct {
-	userRepository repository.UserRepository
+	userRepository          repository.UserRepository
+	mfaRepository           repository.MFARepository
+	signinLockoutRepository repository.SigninLockoutRepository
 }
 
 // NewUserService 함수는 UserService 인터페이스의 구현체를 반환합니다.
-func NewUserService(userRepository repository.UserRepository) UserService {
+func NewUserService(userRepository repository.UserRepository, mfaRepository repository.MFARepository, signinLockoutRepository repository.SigninLockoutRepository) UserService {
 	return &userService{
-		userRepository: userRepository,
+		userRepository:          userRepository,
+		mfaRepository:           mfaRepository,
+		signinLockoutRepository: signinLockoutRepository,
 	}
 }
 
@@ -40,12 +64,18 @@ func (s *userService) SignupUser(ctx context.Context, userSignupDTO dto.UserSign
 		return 0, http.StatusBadRequest, err
 	}
 
+	// 비밀번호 정책 검사
+	if err := utils.ValidatePasswordPolicy(userSignupDTO.Password, userSignupDTO.Username, userSignupDTO.Email); err != nil {
+		return 0, http.StatusBadRequest, err
+	}
+
 	// 비밀번호 암호화를 위한 로직
 	hashedPassword, err := utils.GenerateHashPassword(userSignupDTO.Password)
 	if err != nil {
 		return 0, http.StatusInternalServerError, err
 	}
 	userSignupDTO.Password = hashedPassword
+	userSignupDTO.Email = strings.TrimSpace(userSignupDTO.Email)
 
 	signupID, err := s.userRepository.CreateUser(ctx, userSignupDTO)
 	if err != nil {
@@ -58,63 +88,601 @@ func (s *userService) SignupUser(ctx context.Context, userSignupDTO dto.UserSign
 		return 0, http.StatusInternalServerError, err
 	}
 
+	// 이메일 인증을 사용하는 경우 인증 메일 발송
+	if config.GetConfig().EMAIL_VERIFICATION != config.EMAIL_VERIFICATION_OFF {
+		user := &model.User{ID: signupID, Nickname: userSignupDTO.Nickname, Email: userSignupDTO.Email}
+		if err := sendVerificationEmail(user); err != nil {
+			log.Printf("Failed to send verification email to user %d: %v", signupID, err)
+		}
+	}
+
 	return signupID, http.StatusCreated, nil
 }
 
 // SigninUser 함수는 사용자를 로그인합니다.
-func (s *userService) SigninUser(ctx context.Context, userSigninDTO dto.UserSigninDTO) (string, string, *model.User, int, error) {
+func (s *userService) SigninUser(ctx context.Context, userSigninDTO dto.UserSigninDTO) (*dto.UserSigninResponseDTO, int, error) {
 	if err := userSigninDTO.Validate(); err != nil {
-		return "", "", nil, http.StatusBadRequest, err
+		return nil, http.StatusBadRequest, err
+	}
+
+	// 아이디 또는 IP가 잠겨 있으면 비밀번호를 확인하지 않음
+	if status, err := s.checkSigninLock(ctx, userSigninDTO.Username, userSigninDTO.IP); err != nil {
+		if status == http.StatusTooManyRequests {
+			audit.Record(ctx, model.AUDIT_EVENT_SIGNIN_FAILURE, 0, signinFailureMetadata(userSigninDTO.Username, model.AUDIT_REASON_LOCKED))
+		}
+		return nil, status, err
 	}
 
 	user, err := s.userRepository.FindUserByUsername(ctx, userSigninDTO.Username)
 	if errors.Is(err, apperror.ErrUserNotFound) {
-		return "", "", nil, http.StatusUnauthorized, apperror.ErrUserSigninInvalidUserName
+		compareDummyPassword(userSigninDTO.Password)
+		s.recordSigninFailure(ctx, userSigninDTO.Username, userSigninDTO.IP, nil)
+		audit.Record(ctx, model.AUDIT_EVENT_SIGNIN_FAILURE, 0, signinFailureMetadata(userSigninDTO.Username, model.AUDIT_REASON_INVALID_CREDENTIALS))
+		return nil, http.StatusUnauthorized, apperror.ErrUserSigninInvalidCredentials
 	}
 	if err != nil {
-		return "", "", nil, http.StatusInternalServerError, err
+		return nil, http.StatusInternalServerError, err
 	}
 
 	// 비밀번호 검증
 	if err := utils.CompareHashAndPassword(user.Password, userSigninDTO.Password); err != nil {
-		return "", "", nil, http.StatusUnauthorized, apperror.ErrUserSigninInvalidPassword
+		s.recordSigninFailure(ctx, userSigninDTO.Username, userSigninDTO.IP, &user.ID)
+		audit.Record(ctx, model.AUDIT_EVENT_SIGNIN_FAILURE, user.ID, signinFailureMetadata(userSigninDTO.Username, model.AUDIT_REASON_INVALID_CREDENTIALS))
+		return nil, http.StatusUnauthorized, apperror.ErrUserSigninInvalidCredentials
 	}
+	s.rehashPasswordIfNeeded(ctx, user, userSigninDTO.Password)
 
-	// JWT 토큰 생성 ( access, refresh )
-	accessToken, err := auth.GenerateJWTToken(user.ID)
+	session := &model.Session{
+		UserID:     user.ID,
+		DeviceName: userSigninDTO.DeviceName,
+		IP:         userSigninDTO.IP,
+		UserAgent:  userSigninDTO.UserAgent,
+	}
+	signinResponse, status, err := completeSignin(ctx, user, session, map[string]string{model.AUDIT_META_METHOD: model.AUDIT_SIGNIN_METHOD_PASSWORD})
 	if err != nil {
-		return "", "", nil, http.StatusInternalServerError, err
+		return nil, status, err
+	}
+
+	// 2단계 인증이 남아 있으면 실패 기록을 유지하고, 2단계 인증까지 성공했을 때 초기화
+	if !signinResponse.MFARequired {
+		s.clearSigninFailures(ctx, userSigninDTO.Username)
+	}
+	return signinResponse, status, nil
+}
+
+// signinFailureMetadata 함수는 로그인 실패 감사 로그에 남길 메타데이터를 만듭니다.
+func signinFailureMetadata(username string, reason string) map[string]string {
+	return map[string]string{
+		model.AUDIT_META_METHOD:   model.AUDIT_SIGNIN_METHOD_PASSWORD,
+		model.AUDIT_META_USERNAME: username,
+		model.AUDIT_META_REASON:   reason,
 	}
+}
 
-	refreshToken, err := auth.GenerateRefreshToken(user.ID)
+// completeSignin 함수는 본인 확인(비밀번호, 외부 로그인)을 마친 사용자에게 이메일 인증 정책과 2단계 인증을 적용한 뒤 토큰을 발급합니다.
+// metadata는 로그인 방식 등 감사 로그에 함께 남길 정보입니다.
+func completeSignin(ctx context.Context, user *model.User, session *model.Session, metadata map[string]string) (*dto.UserSigninResponseDTO, int, error) {
+	// 관리자가 비활성화한 계정은 본인 확인에 성공해도 로그인할 수 없음
+	if user.IsDisabled() {
+		metadata[model.AUDIT_META_REASON] = model.AUDIT_REASON_ACCOUNT_DISABLED
+		audit.Record(ctx, model.AUDIT_EVENT_SIGNIN_FAILURE, user.ID, metadata)
+		return nil, http.StatusForbidden, apperror.ErrAuthAccountDisabled
+	}
+
+	// 이메일 인증 정책 확인
+	if user.EmailVerifiedAt == nil {
+		switch config.GetConfig().EMAIL_VERIFICATION {
+		case config.EMAIL_VERIFICATION_REQUIRED:
+			metadata[model.AUDIT_META_REASON] = model.AUDIT_REASON_EMAIL_NOT_VERIFIED
+			audit.Record(ctx, model.AUDIT_EVENT_SIGNIN_FAILURE, user.ID, metadata)
+			return nil, http.StatusForbidden, apperror.ErrUserEmailNotVerified
+		case config.EMAIL_VERIFICATION_READ_ONLY:
+			session.ReadOnly = true
+		}
+	}
+
+	// 2단계 인증을 사용하는 경우 토큰 대신 2단계 인증 대기 토큰 발급
+	if user.IsTOTPEnabled() {
+		mfaToken, status, err := createMFAPending(ctx, session)
+		if err != nil {
+			return nil, status, err
+		}
+		return &dto.UserSigninResponseDTO{MFARequired: true, MFAToken: mfaToken}, http.StatusOK, nil
+	}
+
+	// 새로운 세션 생성 및 토큰 발급 ( access, refresh )
+	accessToken, refreshToken, status, err := createSession(ctx, session)
 	if err != nil {
-		return "", "", nil, http.StatusInternalServerError, err
+		return nil, status, err
+	}
+	metadata[model.AUDIT_META_SESSION_ID] = session.ID
+	audit.Record(ctx, model.AUDIT_EVENT_SIGNIN_SUCCESS, user.ID, metadata)
+
+	return &dto.UserSigninResponseDTO{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		User:         user,
+	}, http.StatusOK, nil
+}
+
+// SigninMFA 함수는 2단계 인증 대기 토큰과 인증 앱 코드(또는 복구 코드)를 확인하고 실제 토큰을 발급합니다.
+func (s *userService) SigninMFA(ctx context.Context, signinMFADTO dto.UserSigninMFADTO) (*dto.UserSigninResponseDTO, int, error) {
+	if err := signinMFADTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	claims, err := auth.ValidateMFAPendingToken(signinMFADTO.MFAToken)
+	if err != nil {
+		return nil, http.StatusUnauthorized, err
 	}
 
-	// AccessToken을 Redis에 저장
 	userRedisClient, err := redis.GetUserRedis(ctx)
 	if err != nil {
-		return "", "", nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	pending, err := userRedisClient.GetMFAPending(ctx, claims.ID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserRedisMFAPendingNotFound) {
+			return nil, http.StatusUnauthorized, apperror.ErrMFAInvalidToken
+		}
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if pending.UserID != claims.UserID {
+		return nil, http.StatusUnauthorized, apperror.ErrMFAInvalidToken
+	}
+
+	// 대기 토큰 하나로 코드를 무한히 대입하지 못하도록 시도 횟수 제한
+	attempts, err := userRedisClient.IncrMFAPendingAttempts(ctx, claims.ID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if attempts > int64(config.GetConfig().MFAMaxAttempts) {
+		userRedisClient.DeleteMFAPending(ctx, claims.ID)
+		return nil, http.StatusTooManyRequests, apperror.ErrMFATooManyAttempts
+	}
+
+	user, err := s.userRepository.FindUserByUserID(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, http.StatusUnauthorized, apperror.ErrMFAInvalidToken
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+	if !user.IsTOTPEnabled() {
+		return nil, http.StatusUnauthorized, apperror.ErrMFAInvalidToken
+	}
+	if user.IsDisabled() {
+		userRedisClient.DeleteMFAPending(ctx, claims.ID)
+		audit.Record(ctx, model.AUDIT_EVENT_SIGNIN_FAILURE, user.ID, map[string]string{model.AUDIT_META_METHOD: model.AUDIT_SIGNIN_METHOD_MFA, model.AUDIT_META_REASON: model.AUDIT_REASON_ACCOUNT_DISABLED})
+		return nil, http.StatusForbidden, apperror.ErrAuthAccountDisabled
+	}
+
+	// 아이디 또는 IP가 잠겨 있으면 코드를 확인하지 않음
+	if status, err := s.checkSigninLock(ctx, user.Username, signinMFADTO.IP); err != nil {
+		if status == http.StatusTooManyRequests {
+			audit.Record(ctx, model.AUDIT_EVENT_SIGNIN_FAILURE, user.ID, map[string]string{model.AUDIT_META_METHOD: model.AUDIT_SIGNIN_METHOD_MFA, model.AUDIT_META_REASON: model.AUDIT_REASON_LOCKED})
+		}
+		return nil, status, err
+	}
+
+	if status, err := verifySecondFactor(ctx, s.mfaRepository, user, signinMFADTO.Code, signinMFADTO.RecoveryCode); err != nil {
+		// 잘못된 코드는 대기 토큰을 새로 발급받아 우회하지 못하도록 비밀번호 실패와 같은 기준으로 집계
+		if status == http.StatusUnauthorized {
+			s.recordSigninFailure(ctx, user.Username, signinMFADTO.IP, &user.ID)
+		}
+		audit.Record(ctx, model.AUDIT_EVENT_SIGNIN_FAILURE, user.ID, map[string]string{model.AUDIT_META_METHOD: model.AUDIT_SIGNIN_METHOD_MFA, model.AUDIT_META_REASON: model.AUDIT_REASON_INVALID_MFA_CODE})
+		return nil, status, err
+	}
+
+	// 동시에 들어온 요청 중 하나만 세션을 만들 수 있도록 대기 정보를 먼저 삭제
+	deleted, err := userRedisClient.DeleteMFAPending(ctx, claims.ID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if !deleted {
+		return nil, http.StatusUnauthorized, apperror.ErrMFAInvalidToken
 	}
 
-	if err := userRedisClient.SetUserToken(ctx, user.ID, accessToken); err != nil {
-		return "", "", nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	// 기기 이름과 읽기 전용 여부는 최초 로그인 요청의 값을, 접속 정보는 최신 요청의 값을 사용
+	pending.IP = signinMFADTO.IP
+	pending.UserAgent = signinMFADTO.UserAgent
+	accessToken, refreshToken, status, err := createSession(ctx, pending)
+	if err != nil {
+		return nil, status, err
 	}
+	s.clearSigninFailures(ctx, user.Username)
+	audit.Record(ctx, model.AUDIT_EVENT_SIGNIN_SUCCESS, user.ID, map[string]string{model.AUDIT_META_METHOD: model.AUDIT_SIGNIN_METHOD_MFA, model.AUDIT_META_SESSION_ID: pending.ID})
 
-	return accessToken, refreshToken, user, http.StatusOK, nil
+	return &dto.UserSigninResponseDTO{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		User:         user,
+	}, http.StatusOK, nil
 }
 
-// SignoutUser 함수는 사용자를 로그아웃합니다.
-func (s *userService) SignoutUser(ctx context.Context, userID int64) (int, error) {
+// SignoutUser 함수는 현재 세션만 로그아웃합니다.
+func (s *userService) SignoutUser(ctx context.Context, userID int64, sessionID string) (int, error) {
 	userRedisClient, err := redis.GetUserRedis(ctx)
 	if err != nil {
 		return http.StatusInternalServerError, apperror.ErrInternalServerError
 	}
 
-	userRedisClient.DeleteUserToken(ctx, userID)
+	userRedisClient.DeleteSession(ctx, userID, sessionID)
+	audit.Record(ctx, model.AUDIT_EVENT_SIGNOUT, userID, map[string]string{model.AUDIT_META_SESSION_ID: sessionID})
 	return http.StatusOK, nil
 }
 
+// SignoutOtherSessions 함수는 현재 세션을 제외한 사용자의 모든 세션을 로그아웃합니다.
+func (s *userService) SignoutOtherSessions(ctx context.Context, userID int64, sessionID string) (int, error) {
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	if err := userRedisClient.DeleteUserSessions(ctx, userID, sessionID); err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	audit.Record(ctx, model.AUDIT_EVENT_SIGNOUT_OTHERS, userID, map[string]string{model.AUDIT_META_SESSION_ID: sessionID})
+	return http.StatusOK, nil
+}
+
+// GetSessions 함수는 사용자의 로그인 세션 목록을 반환합니다.
+func (s *userService) GetSessions(ctx context.Context, userID int64, sessionID string) ([]*model.Session, int, error) {
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	sessions, err := userRedisClient.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	// 최근 사용한 세션이 먼저 오도록 정렬
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+	for _, session := range sessions {
+		session.Current = session.ID == sessionID
+	}
+
+	return sessions, http.StatusOK, nil
+}
+
+// RevokeSession 함수는 사용자의 특정 세션을 폐기합니다.
+func (s *userService) RevokeSession(ctx context.Context, userID int64, sessionID string) (int, error) {
+	if sessionID == "" {
+		return http.StatusBadRequest, apperror.ErrUserSessionIDRequired
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	// 다른 사용자의 세션은 키가 달라 조회되지 않으므로 소유권 확인을 겸함
+	if _, err := userRedisClient.GetSession(ctx, userID, sessionID); err != nil {
+		if errors.Is(err, apperror.ErrUserRedisSessionNotFound) {
+			return http.StatusNotFound, apperror.ErrUserSessionNotFound
+		}
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	if err := userRedisClient.DeleteSession(ctx, userID, sessionID); err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	audit.Record(ctx, model.AUDIT_EVENT_SESSION_REVOKE, userID, map[string]string{model.AUDIT_META_SESSION_ID: sessionID})
+	return http.StatusOK, nil
+}
+
+// RefreshToken 함수는 리프레시 토큰을 검증하고 새로운 액세스/리프레시 토큰을 발급합니다.
+// 리프레시 토큰은 사용할 때마다 교체되며, 이미 사용된 토큰이 제출되면 해당 세션 전체를 폐기합니다.
+func (s *userService) RefreshToken(ctx context.Context, userRefreshDTO dto.UserRefreshDTO) (string, string, int, error) {
+	if err := userRefreshDTO.Validate(); err != nil {
+		return "", "", http.StatusBadRequest, err
+	}
+
+	claims, err := auth.ValidateRefreshToken(userRefreshDTO.RefreshToken)
+	if err != nil {
+		return "", "", http.StatusUnauthorized, err
+	}
+
+	// 탈퇴 등으로 존재하지 않는 사용자의 토큰은 거부
+	user, err := s.userRepository.FindUserByUserID(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return "", "", http.StatusUnauthorized, apperror.ErrAuthInvalidRefreshToken
+		}
+		return "", "", http.StatusInternalServerError, err
+	}
+	if user.IsDisabled() {
+		return "", "", http.StatusForbidden, apperror.ErrAuthAccountDisabled
+	}
+
+	// 리프레시 토큰 패밀리 ID는 세션 ID와 동일
+	sessionID := claims.FamilyID
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return "", "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	accessToken, refreshToken, status, err := s.rotateSessionTokens(ctx, claims.UserID, sessionID, claims.ID)
+	if err != nil {
+		return "", "", status, err
+	}
+
+	if err := userRedisClient.RefreshSession(ctx, claims.UserID, sessionID, userRefreshDTO.IP, userRefreshDTO.UserAgent); err != nil {
+		// 토큰 교체 직후 세션이 폐기된 경우
+		if errors.Is(err, apperror.ErrUserRedisSessionNotFound) {
+			return "", "", http.StatusUnauthorized, apperror.ErrAuthInvalidRefreshToken
+		}
+		return "", "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	audit.Record(ctx, model.AUDIT_EVENT_TOKEN_REFRESH, claims.UserID, map[string]string{model.AUDIT_META_SESSION_ID: sessionID})
+
+	return accessToken, refreshToken, http.StatusOK, nil
+}
+
+// ChangePassword 함수는 현재 비밀번호를 확인한 뒤 새 비밀번호로 변경합니다.
+// 외부 로그인으로 가입해 비밀번호가 없는 사용자는 현재 비밀번호 없이 새 비밀번호를 설정합니다.
+// 기존의 모든 세션을 폐기하고, 요청한 기기에는 새로운 세션의 토큰을 발급합니다.
+func (s *userService) ChangePassword(ctx context.Context, userID int64, sessionID string, changePasswordDTO dto.UserChangePasswordDTO) (string, string, int, error) {
+	if err := changePasswordDTO.Validate(); err != nil {
+		return "", "", http.StatusBadRequest, err
+	}
+
+	user, err := s.userRepository.FindUserByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return "", "", http.StatusNotFound, err
+		}
+		return "", "", http.StatusInternalServerError, err
+	}
+
+	// 현재 비밀번호 검증 (비밀번호가 없는 외부 로그인 계정은 확인할 비밀번호가 없음)
+	if user.HasPassword() {
+		if strings.TrimSpace(changePasswordDTO.CurrentPassword) == "" {
+			return "", "", http.StatusBadRequest, apperror.ErrUserPasswordCurrentRequired
+		}
+		if err := utils.CompareHashAndPassword(user.Password, changePasswordDTO.CurrentPassword); err != nil {
+			return "", "", http.StatusUnauthorized, apperror.ErrUserPasswordInvalidCurrent
+		}
+	}
+
+	// 새 비밀번호 정책 검사
+	if err := utils.ValidatePasswordPolicy(changePasswordDTO.NewPassword, user.Username, user.Email); err != nil {
+		return "", "", http.StatusBadRequest, err
+	}
+
+	hashedPassword, err := utils.GenerateHashPassword(changePasswordDTO.NewPassword)
+	if err != nil {
+		return "", "", http.StatusInternalServerError, err
+	}
+	if err := s.userRepository.UpdatePassword(ctx, userID, hashedPassword); err != nil {
+		return "", "", http.StatusInternalServerError, err
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return "", "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	// 현재 세션의 기기 정보와 읽기 전용 여부를 새 세션으로 이어받음 (비밀번호 변경으로 쓰기 권한을 얻지 못하도록)
+	session := &model.Session{UserID: userID}
+	if current, err := userRedisClient.GetSession(ctx, userID, sessionID); err == nil {
+		session.DeviceName = current.DeviceName
+		session.IP = current.IP
+		session.UserAgent = current.UserAgent
+		session.ReadOnly = current.ReadOnly
+	} else if user.EmailVerifiedAt == nil && config.GetConfig().EMAIL_VERIFICATION == config.EMAIL_VERIFICATION_READ_ONLY {
+		// 현재 세션을 불러오지 못하면 로그인과 같은 이메일 인증 정책으로 다시 판단
+		session.ReadOnly = true
+	}
+
+	// 현재 세션을 포함한 모든 기존 세션의 토큰을 폐기
+	if err := userRedisClient.DeleteUserSessions(ctx, userID, ""); err != nil {
+		return "", "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	accessToken, refreshToken, status, err := createSession(ctx, session)
+	if err != nil {
+		return "", "", status, err
+	}
+	audit.Record(ctx, model.AUDIT_EVENT_PASSWORD_CHANGE, userID, map[string]string{model.AUDIT_META_SESSION_ID: session.ID})
+
+	return accessToken, refreshToken, http.StatusOK, nil
+}
+
+// RequestPasswordReset 함수는 비밀번호 재설정 토큰을 발급하여 메일로 전송합니다.
+// 가입 여부를 노출하지 않기 위해 존재하지 않는 이메일이어도 동일한 응답을 반환합니다.
+func (s *userService) RequestPasswordReset(ctx context.Context, resetRequestDTO dto.UserPasswordResetRequestDTO) (int, error) {
+	if err := resetRequestDTO.Validate(); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	user, err := s.userRepository.FindUserByEmail(ctx, strings.TrimSpace(resetRequestDTO.Email))
+	if errors.Is(err, apperror.ErrUserNotFound) {
+		return http.StatusAccepted, nil
+	}
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	// 원본 토큰은 메일로만 전달하고 저장소에는 해시만 보관
+	token, err := utils.GenerateSecureToken()
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if err := userRedisClient.SetPasswordResetToken(ctx, user.ID, utils.HashToken(token)); err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	mailClient, err := mailer.GetMailer()
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	cfg := config.GetConfig()
+	link := cfg.APP_BASE_URL + "/password-reset?token=" + url.QueryEscape(token)
+	mailer.SendAsync(mailClient, &mailer.Message{
+		To:      user.Email,
+		Subject: "[Dairify] 비밀번호 재설정 안내",
+		Body: fmt.Sprintf("%s님, 안녕하세요.\n\n아래 링크에서 비밀번호를 재설정할 수 있습니다. 링크는 %d분 동안 한 번만 사용할 수 있습니다.\n\n%s\n\n본인이 요청하지 않았다면 이 메일을 무시해주세요.\n",
+			user.Nickname, int(cfg.PasswordResetExpiry.Minutes()), link),
+	})
+
+	return http.StatusAccepted, nil
+}
+
+// ConfirmPasswordReset 함수는 재설정 토큰을 확인하고 새 비밀번호를 설정합니다. 토큰은 한 번만 사용할 수 있으며 모든 세션이 폐기됩니다.
+func (s *userService) ConfirmPasswordReset(ctx context.Context, resetConfirmDTO dto.UserPasswordResetConfirmDTO) (int, error) {
+	if err := resetConfirmDTO.Validate(); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	// 아이디, 이메일이 포함된 비밀번호를 거부하려면 사용자 정보가 필요하므로 토큰을 소모하지 않고 먼저 조회
+	tokenHash := utils.HashToken(resetConfirmDTO.Token)
+	userID, err := userRedisClient.GetPasswordResetToken(ctx, tokenHash)
+	if errors.Is(err, apperror.ErrUserRedisPasswordResetTokenNotFound) {
+		return http.StatusBadRequest, apperror.ErrUserPasswordResetInvalidToken
+	}
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	user, err := s.userRepository.FindUserByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return http.StatusBadRequest, apperror.ErrUserPasswordResetInvalidToken
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	// 정책 위반 시 토큰을 소모하지 않도록 먼저 검사
+	if err := utils.ValidatePasswordPolicy(resetConfirmDTO.NewPassword, user.Username, user.Email); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	hashedPassword, err := utils.GenerateHashPassword(resetConfirmDTO.NewPassword)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	// 조회 이후 토큰이 사용되었거나 새로 발급된 경우 거부
+	consumedUserID, err := userRedisClient.ConsumePasswordResetToken(ctx, tokenHash)
+	if errors.Is(err, apperror.ErrUserRedisPasswordResetTokenNotFound) || (err == nil && consumedUserID != userID) {
+		return http.StatusBadRequest, apperror.ErrUserPasswordResetInvalidToken
+	}
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	if err := s.userRepository.UpdatePassword(ctx, userID, hashedPassword); err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return http.StatusBadRequest, apperror.ErrUserPasswordResetInvalidToken
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	if err := userRedisClient.DeleteUserSessions(ctx, userID, ""); err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	audit.Record(ctx, model.AUDIT_EVENT_PASSWORD_RESET, userID, nil)
+
+	return http.StatusOK, nil
+}
+
+// createSession 함수는 새로운 세션을 저장하고 해당 세션의 액세스/리프레시 토큰을 발급합니다.
+// 세션 ID는 액세스 토큰의 jti이자 리프레시 토큰 패밀리 ID로 사용됩니다.
+func createSession(ctx context.Context, session *model.Session) (string, string, int, error) {
+	sessionID, err := auth.NewTokenID()
+	if err != nil {
+		return "", "", http.StatusInternalServerError, err
+	}
+	refreshTokenID, err := auth.NewTokenID()
+	if err != nil {
+		return "", "", http.StatusInternalServerError, err
+	}
+
+	accessToken, err := auth.GenerateJWTToken(session.UserID, sessionID)
+	if err != nil {
+		return "", "", http.StatusInternalServerError, err
+	}
+	refreshToken, err := auth.GenerateRefreshToken(session.UserID, sessionID, refreshTokenID)
+	if err != nil {
+		return "", "", http.StatusInternalServerError, err
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return "", "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	now := time.Now()
+	session.ID = sessionID
+	session.CreatedAt = now
+	session.LastSeenAt = now
+	if err := userRedisClient.CreateSession(ctx, session); err != nil {
+		return "", "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if err := userRedisClient.SetRefreshFamily(ctx, session.UserID, sessionID, refreshTokenID); err != nil {
+		return "", "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	return accessToken, refreshToken, http.StatusOK, nil
+}
+
+// rotateSessionTokens 함수는 기존 세션의 리프레시 토큰을 교체하고 새로운 토큰 쌍을 발급합니다.
+// currentTokenID가 세션에 저장된 토큰 ID와 다르면 재사용으로 판단하여 세션을 폐기합니다.
+func (s *userService) rotateSessionTokens(ctx context.Context, userID int64, sessionID string, currentTokenID string) (string, string, int, error) {
+	// 새 토큰을 먼저 생성한 뒤 패밀리를 교체하여, 서명 실패로 패밀리가 끊기는 일을 방지
+	accessToken, err := auth.GenerateJWTToken(userID, sessionID)
+	if err != nil {
+		return "", "", http.StatusInternalServerError, err
+	}
+	refreshTokenID, err := auth.NewTokenID()
+	if err != nil {
+		return "", "", http.StatusInternalServerError, err
+	}
+	refreshToken, err := auth.GenerateRefreshToken(userID, sessionID, refreshTokenID)
+	if err != nil {
+		return "", "", http.StatusInternalServerError, err
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return "", "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	err = userRedisClient.RotateRefreshFamily(ctx, userID, sessionID, currentTokenID, refreshTokenID)
+	if errors.Is(err, apperror.ErrUserRedisRefreshTokenReused) {
+		// 재사용이 감지되면 세션과 리프레시 토큰 패밀리 전체를 폐기
+		userRedisClient.DeleteSession(ctx, userID, sessionID)
+		audit.Record(ctx, model.AUDIT_EVENT_TOKEN_REFRESH_REUSED, userID, map[string]string{model.AUDIT_META_SESSION_ID: sessionID})
+		return "", "", http.StatusUnauthorized, apperror.ErrAuthRefreshTokenReused
+	}
+	if errors.Is(err, apperror.ErrUserRedisRefreshFamilyNotFound) {
+		return "", "", http.StatusUnauthorized, apperror.ErrAuthInvalidRefreshToken
+	}
+	if err != nil {
+		return "", "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	return accessToken, refreshToken, http.StatusOK, nil
+}
+
 // Profile 함수는 사용자의 프로필 정보를 반환합니다.
 func (s *userService) Profile(ctx context.Context, userID int64) (*model.User, int, error) {
 	user, err := s.userRepository.FindUserByUserID(ctx, userID)
@@ -124,3 +692,215 @@ func (s *userService) Profile(ctx context.Context, userID int64) (*model.User, i
 
 	return user, http.StatusOK, nil
 }
+
+// UpdateProfile 함수는 사용자의 닉네임, 이메일, 시간대, 일기 수정 기록 보관 수를 변경합니다. 이메일 변경 시에는 현재 비밀번호를 확인합니다.
+func (s *userService) UpdateProfile(ctx context.Context, userID int64, updateProfileDTO dto.UserUpdateProfileDTO) (*model.User, int, error) {
+	if err := updateProfileDTO.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	user, err := s.userRepository.FindUserByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+
+	if updateProfileDTO.Nickname != nil {
+		user.Nickname = strings.TrimSpace(*updateProfileDTO.Nickname)
+	}
+	if updateProfileDTO.Timezone != nil {
+		user.Timezone = strings.TrimSpace(*updateProfileDTO.Timezone)
+	}
+	if updateProfileDTO.DiaryRevisionLimit != nil {
+		user.DiaryRevisionLimit = *updateProfileDTO.DiaryRevisionLimit
+	}
+
+	emailChanged := false
+	if updateProfileDTO.Email != nil {
+		email := strings.TrimSpace(*updateProfileDTO.Email)
+		if email != user.Email {
+			emailChanged = true
+			// 계정 탈취 방지를 위해 이메일 변경은 비밀번호 재확인이 필요 (비밀번호가 없는 외부 로그인 계정은 제외)
+			if user.HasPassword() {
+				if updateProfileDTO.CurrentPassword == "" {
+					return nil, http.StatusBadRequest, apperror.ErrUserProfilePasswordRequired
+				}
+				if err := utils.CompareHashAndPassword(user.Password, updateProfileDTO.CurrentPassword); err != nil {
+					return nil, http.StatusUnauthorized, apperror.ErrUserPasswordInvalidCurrent
+				}
+			}
+			user.Email = email
+		}
+	}
+
+	if err := s.userRepository.UpdateProfile(ctx, user); err != nil {
+		if errors.Is(err, apperror.ErrUserSignupDuplicateEmail) {
+			return nil, http.StatusConflict, err
+		}
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return nil, http.StatusNotFound, err
+		}
+		return nil, http.StatusInternalServerError, err
+	}
+
+	// 이메일이 바뀌면 인증 상태가 초기화되므로 새 주소로 인증 메일 발송
+	if emailChanged {
+		audit.Record(ctx, model.AUDIT_EVENT_EMAIL_CHANGE, user.ID, nil)
+		user.EmailVerifiedAt = nil
+		mode := config.GetConfig().EMAIL_VERIFICATION
+		if mode != config.EMAIL_VERIFICATION_OFF {
+			if err := sendVerificationEmail(user); err != nil {
+				log.Printf("Failed to send verification email to user %d: %v", user.ID, err)
+			}
+		}
+		if mode == config.EMAIL_VERIFICATION_READ_ONLY {
+			if userRedisClient, err := redis.GetUserRedis(ctx); err == nil {
+				userRedisClient.SetUserSessionsReadOnly(ctx, user.ID, true)
+			}
+		}
+	}
+
+	return user, http.StatusOK, nil
+}
+
+// VerifyEmail 함수는 이메일 인증 토큰을 확인하고 사용자의 이메일을 인증 완료 상태로 변경합니다.
+func (s *userService) VerifyEmail(ctx context.Context, verifyEmailDTO dto.UserVerifyEmailDTO) (int, error) {
+	if err := verifyEmailDTO.Validate(); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	claims, err := auth.ValidateEmailVerificationToken(verifyEmailDTO.Token)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	// 토큰 발급 후 이메일이 변경되었다면 갱신되지 않음
+	if err := s.userRepository.MarkEmailVerified(ctx, claims.UserID, claims.Email); err != nil {
+		if errors.Is(err, apperror.ErrUserNotFound) {
+			return http.StatusBadRequest, apperror.ErrUserEmailVerifyInvalidToken
+		}
+		return http.StatusInternalServerError, err
+	}
+
+	// 읽기 전용으로 로그인한 세션에 쓰기 권한 부여
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if err := userRedisClient.SetUserSessionsReadOnly(ctx, claims.UserID, false); err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	return http.StatusOK, nil
+}
+
+// ResendVerificationEmail 함수는 인증 메일을 다시 발송합니다.
+// 가입 여부를 노출하지 않기 위해 대상이 없거나 이미 인증된 경우에도 동일한 응답을 반환합니다.
+func (s *userService) ResendVerificationEmail(ctx context.Context, resendDTO dto.UserResendVerificationDTO) (int, error) {
+	if err := resendDTO.Validate(); err != nil {
+		return http.StatusBadRequest, err
+	}
+
+	cfg := config.GetConfig()
+	if cfg.EMAIL_VERIFICATION == config.EMAIL_VERIFICATION_OFF {
+		return http.StatusAccepted, nil
+	}
+
+	user, err := s.userRepository.FindUserByEmail(ctx, strings.TrimSpace(resendDTO.Email))
+	if errors.Is(err, apperror.ErrUserNotFound) {
+		return http.StatusAccepted, nil
+	}
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if user.EmailVerifiedAt != nil {
+		return http.StatusAccepted, nil
+	}
+
+	// 메일 폭탄 방지를 위해 재발송 간격 제한
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	acquired, err := userRedisClient.AcquireEmailVerifyCooldown(ctx, user.ID, cfg.EmailVerificationCooldown)
+	if err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if !acquired {
+		return http.StatusAccepted, nil
+	}
+
+	if err := sendVerificationEmail(user); err != nil {
+		return http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	return http.StatusAccepted, nil
+}
+
+// createMFAPending 함수는 2단계 인증을 기다리는 로그인 정보를 저장하고 대기 토큰을 발급합니다.
+func createMFAPending(ctx context.Context, session *model.Session) (string, int, error) {
+	pendingID, err := auth.NewTokenID()
+	if err != nil {
+		return "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	userRedisClient, err := redis.GetUserRedis(ctx)
+	if err != nil {
+		return "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+	if err := userRedisClient.SetMFAPending(ctx, pendingID, session, config.GetConfig().MFAPendingExpiry); err != nil {
+		return "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	mfaToken, err := auth.GenerateMFAPendingToken(session.UserID, pendingID)
+	if err != nil {
+		return "", http.StatusInternalServerError, apperror.ErrInternalServerError
+	}
+
+	return mfaToken, http.StatusOK, nil
+}
+
+// rehashPasswordIfNeeded 함수는 로그인에 성공한 사용자의 비밀번호 해시가 이전 알고리즘이나 약한 파라미터로 만들어졌으면
+// 현재 설정으로 다시 해시화하여 저장합니다. 실패해도 로그인은 계속 진행합니다.
+func (s *userService) rehashPasswordIfNeeded(ctx context.Context, user *model.User, password string) {
+	if !utils.PasswordNeedsRehash(user.Password) {
+		return
+	}
+
+	hashedPassword, err := utils.GenerateHashPassword(password)
+	if err != nil {
+		log.Printf("Failed to rehash password for user %d: %v", user.ID, err)
+		return
+	}
+	if err := s.userRepository.UpgradePasswordHash(ctx, user.ID, user.Password, hashedPassword); err != nil {
+		log.Printf("Failed to upgrade password hash for user %d: %v", user.ID, err)
+		return
+	}
+	user.Password = hashedPassword
+}
+
+// sendVerificationEmail 함수는 사용자의 현재 이메일 주소로 서명된 인증 링크를 발송합니다.
+func sendVerificationEmail(user *model.User) error {
+	token, err := auth.GenerateEmailVerificationToken(user.ID, user.Email)
+	if err != nil {
+		return err
+	}
+
+	mailClient, err := mailer.GetMailer()
+	if err != nil {
+		return err
+	}
+
+	cfg := config.GetConfig()
+	link := cfg.APP_BASE_URL + "/verify-email?token=" + url.QueryEscape(token)
+	mailer.SendAsync(mailClient, &mailer.Message{
+		To:      user.Email,
+		Subject: "[Dairify] 이메일 주소를 인증해주세요",
+		Body: fmt.Sprintf("%s님, 안녕하세요.\n\n아래 링크를 눌러 이메일 주소 인증을 완료해주세요. 링크는 %d시간 동안 유효합니다.\n\n%s\n",
+			user.Nickname, int(cfg.EmailVerificationExpiry.Hours()), link),
+	})
+
+	return nil
+}
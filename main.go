@@ -9,9 +9,11 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/jhphon0730/dairify/internal/auth"
 	"github.com/jhphon0730/dairify/internal/config"
 	"github.com/jhphon0730/dairify/internal/database"
 	"github.com/jhphon0730/dairify/internal/server"
+	"github.com/jhphon0730/dairify/pkg/utils"
 )
 
 func main() {
@@ -24,6 +26,26 @@ func main() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
+	// JWT 서명 키 확인 (비어 있거나 약한 키로는 서버를 시작하지 않음)
+	if _, err := auth.LoadKeyring(); err != nil {
+		log.Fatalf("Failed to load JWT signing keys: %v", err)
+	}
+
+	// 비밀번호 해시 알고리즘 설정 확인
+	if _, err := utils.LoadPasswordHasher(); err != nil {
+		log.Fatalf("Failed to load password hasher: %v", err)
+	}
+
+	// 이메일 인증 정책 확인 (오타로 미인증 계정에 모든 기능이 허용되지 않도록)
+	if err := config.ValidateEmailVerification(); err != nil {
+		log.Fatalf("Invalid email verification policy: %v", err)
+	}
+
+	// 기본 시간대 확인
+	if _, err := utils.LoadTimezone(config.DEFAULT_TIMEZONE); err != nil {
+		log.Fatalf("Failed to load default timezone %q: %v", config.DEFAULT_TIMEZONE, err)
+	}
+
 	// 데이터베이스 연결 및 스키마 적용
 	db := database.GetDB()
 	if db == nil {
@@ -38,6 +60,10 @@ func main() {
 	// HTTP 서버 설정
 	muxSrv := server.NewServer(PORT, db)
 
+	// 백그라운드 작업 실행
+	scheduler := server.SetupJobs(db)
+	scheduler.Start()
+
 	// OS 종료 신호 처리
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
@@ -56,5 +82,6 @@ func main() {
 
 	// 서버 종료
 	muxSrv.Shutdown(ctx)
+	scheduler.Stop()
 	log.Println("Server stopped")
 }
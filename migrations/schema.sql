@@ -7,6 +7,105 @@ CREATE TABLE IF NOT EXISTS users (
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
+-- 이메일 인증 완료 시각 (NULL이면 미인증)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at TIMESTAMP NULL;
+
+-- 계정 삭제 예정 시각 (NULL이면 삭제 예정 없음, 유예 기간이 지나면 백그라운드 작업이 삭제)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deletion_scheduled_at TIMESTAMP NULL;
+CREATE INDEX IF NOT EXISTS idx_users_deletion_scheduled_at ON users(deletion_scheduled_at);
+
+-- TOTP 2단계 인증 (secret만 있고 enabled_at이 NULL이면 등록 확인 대기 상태)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS totp_secret VARCHAR(64) NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS totp_enabled_at TIMESTAMP NULL;
+
+-- 사용자 역할 (user 또는 admin, 관리자는 DB에서 직접 지정)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'user' CONSTRAINT chk_user_role CHECK (role IN ('user', 'admin'));
+
+-- 계정 비활성화 시각 (NULL이면 활성 상태, 관리자가 비활성화하면 로그인과 API 호출이 거부됨)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMP NULL;
+
+-- 날짜별 조회, 캘린더에 사용할 IANA 시간대 (빈 값이면 서버 기본 시간대 사용)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT '';
+
+-- 일기별로 보관할 최대 수정 기록 수 (초과한 오래된 기록은 일기를 수정할 때 삭제)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS diary_revision_limit INTEGER NOT NULL DEFAULT 50 CONSTRAINT chk_diary_revision_limit CHECK (diary_revision_limit BETWEEN 1 AND 200);
+
+-- 2단계 인증 복구 코드 (SHA-256 해시만 저장, 한 번 사용하면 used_at 기록)
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_recovery_code_per_user UNIQUE (user_id, code_hash)
+);
+
+-- 로그인 잠금 기록 (범위는 username 또는 ip, 존재하지 않는 아이디도 기록하므로 user_id는 NULL 허용)
+CREATE TABLE IF NOT EXISTS signin_lockouts (
+    id BIGSERIAL PRIMARY KEY,
+    scope VARCHAR(20) NOT NULL,
+    identifier VARCHAR(255) NOT NULL,
+    user_id INTEGER NULL REFERENCES users(id) ON DELETE SET NULL,
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    failure_count INTEGER NOT NULL,
+    locked_until TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_signin_lockouts_created_at ON signin_lockouts(created_at);
+CREATE INDEX IF NOT EXISTS idx_signin_lockouts_identifier ON signin_lockouts(scope, identifier);
+
+-- 개인 액세스 토큰 (스크립트 및 외부 연동용, SHA-256 해시만 저장)
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    token_prefix VARCHAR(20) NOT NULL,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    scope VARCHAR(20) NOT NULL,
+    expires_at TIMESTAMP NULL,
+    last_used_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_personal_access_token_scope CHECK (scope IN ('read', 'read_write'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_id ON personal_access_tokens(user_id);
+
+-- 외부 로그인(OpenID Connect) 계정 연결 (제공자별 subject로 사용자 식별, 제공자당 한 계정만 연결)
+CREATE TABLE IF NOT EXISTS user_identities (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(100) NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_identity_provider_subject UNIQUE (provider, subject),
+    CONSTRAINT unique_identity_user_provider UNIQUE (user_id, provider)
+);
+
+-- 보안 감사 로그 (로그인, 로그아웃, 토큰 재발급, 비밀번호 변경, 관리자 작업 등)
+-- 추가만 가능하며 수정과 삭제는 규칙으로 막음. 계정이 영구 삭제되어도 기록이 남도록 user_id에는 외래 키를 두지 않음
+-- user_id는 존재하지 않는 아이디로 로그인에 실패한 경우 NULL, actor_id는 관리자가 수행한 작업의 관리자 ID
+CREATE TABLE IF NOT EXISTS audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NULL,
+    actor_id INTEGER NULL,
+    event_type VARCHAR(50) NOT NULL,
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    metadata JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_user_id ON audit_events(user_id, id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_event_type ON audit_events(event_type, id);
+CREATE OR REPLACE RULE audit_events_no_update AS ON UPDATE TO audit_events DO INSTEAD NOTHING;
+CREATE OR REPLACE RULE audit_events_no_delete AS ON DELETE TO audit_events DO INSTEAD NOTHING;
+
+-- 기존 데이터베이스의 계정 삭제 시 함께 삭제되던 외래 키 제거
+ALTER TABLE audit_events DROP CONSTRAINT IF EXISTS audit_events_user_id_fkey;
+
 CREATE TABLE IF NOT EXISTS categories (
     id SERIAL PRIMARY KEY,
     name VARCHAR(50) NOT NULL,
@@ -15,6 +114,27 @@ CREATE TABLE IF NOT EXISTS categories (
     CONSTRAINT unique_category_per_user UNIQUE (name, creator_id)
 );
 
+-- 커서 페이지네이션 (created_at, id) 정렬용 인덱스
+CREATE INDEX IF NOT EXISTS idx_categories_creator_created_at ON categories(creator_id, created_at DESC, id DESC);
+
+-- 감정 태그 목록 (새 감정은 행을 추가하여 확장, sort_order 순으로 표시)
+CREATE TABLE IF NOT EXISTS moods (
+    code VARCHAR(30) PRIMARY KEY,
+    label VARCHAR(50) NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO moods (code, label, sort_order) VALUES
+    ('happy', '행복', 10),
+    ('excited', '신남', 20),
+    ('grateful', '감사', 30),
+    ('calm', '평온', 40),
+    ('tired', '피곤', 50),
+    ('anxious', '불안', 60),
+    ('sad', '슬픔', 70),
+    ('angry', '화남', 80)
+ON CONFLICT (code) DO NOTHING;
+
 CREATE TABLE IF NOT EXISTS diaries (
     id SERIAL PRIMARY KEY,
     creator_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
@@ -38,6 +158,18 @@ ALTER TABLE diaries ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP NULL;
 -- 컬럼 존재가 보장된 이후 인덱스 생성
 CREATE INDEX IF NOT EXISTS idx_diaries_is_deleted ON diaries(is_deleted);
 CREATE INDEX IF NOT EXISTS idx_diaries_is_deleted2 ON diaries(is_deleted, creator_id);
+-- 커서 페이지네이션 (created_at, id) 정렬용 인덱스
+CREATE INDEX IF NOT EXISTS idx_diaries_creator_created_at ON diaries(creator_id, created_at DESC, id DESC) WHERE is_deleted = FALSE;
+-- 일기 감정 태그와 강도 (1~5, 강도는 감정이 있을 때만 지정)
+ALTER TABLE diaries ADD COLUMN IF NOT EXISTS mood VARCHAR(30) NULL REFERENCES moods(code) ON UPDATE CASCADE;
+ALTER TABLE diaries ADD COLUMN IF NOT EXISTS mood_intensity SMALLINT NULL CONSTRAINT chk_mood_intensity CHECK (mood_intensity BETWEEN 1 AND 5);
+CREATE INDEX IF NOT EXISTS idx_diaries_creator_mood ON diaries(creator_id, mood) WHERE mood IS NOT NULL;
+-- 낙관적 동시성 제어용 버전 ( 일기를 수정할 때마다 1씩 증가하며 ETag로 사용 )
+ALTER TABLE diaries ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+-- 제목, 본문 부분 일치 검색용 trigram 인덱스 ( 한국어는 형태소 분석 대신 글자 단위로 검색 )
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE INDEX IF NOT EXISTS idx_diaries_title_trgm ON diaries USING GIN (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_diaries_content_trgm ON diaries USING GIN (content gin_trgm_ops);
 
 -- 이미지 메타데이터 테이블 (1:N: diary -> images)
 CREATE TABLE IF NOT EXISTS "images" (
@@ -54,4 +186,45 @@ CREATE TABLE IF NOT EXISTS "images" (
     ON DELETE CASCADE
 );
 
-CREATE INDEX IF NOT EXISTS "idx_images_diary_id" ON "images"("diary_id");
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS "idx_images_diary_id" ON "images"("diary_id");
+
+-- 일기 태그 테이블 (사용자별, 이름은 대소문자 구분 없이 고유)
+CREATE TABLE IF NOT EXISTS tags (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(30) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT chk_tag_name CHECK (LENGTH(name) > 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tags_creator_lower_name ON tags(creator_id, LOWER(name));
+
+-- 일기와 태그 연결 테이블 (N:M: diaries <-> tags)
+CREATE TABLE IF NOT EXISTS diary_tags (
+    diary_id INTEGER NOT NULL REFERENCES diaries(id) ON DELETE CASCADE,
+    tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (diary_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_diary_tags_tag_id ON diary_tags(tag_id);
+
+-- 일기 수정 기록 테이블 (1:N: diary -> diary_revisions, revision은 일기별로 1부터 증가)
+CREATE TABLE IF NOT EXISTS diary_revisions (
+    id SERIAL PRIMARY KEY,
+    diary_id INTEGER NOT NULL REFERENCES diaries(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    content TEXT NOT NULL,
+    restored_from INTEGER,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_diary_revisions_diary_revision UNIQUE (diary_id, revision)
+);
+
+-- 수정 기록 기능 이전에 작성된 일기는 현재 내용을 첫 번째 수정 기록으로 채움
+INSERT INTO diary_revisions (diary_id, revision, title, content, created_at)
+SELECT d.id, 1, d.title, d.content, d.updated_at
+FROM diaries d
+WHERE NOT EXISTS (SELECT 1 FROM diary_revisions r WHERE r.diary_id = d.id);
+
+-- 휴지통 보관 기간이 지난 일기 영구 삭제용 인덱스
+CREATE INDEX IF NOT EXISTS idx_diaries_deleted_at ON diaries(deleted_at) WHERE is_deleted = TRUE;
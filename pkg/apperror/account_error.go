@@ -0,0 +1,12 @@
+package apperror
+
+import "errors"
+
+var (
+	ErrAccountPasswordRequired       = errors.New("계정을 삭제하려면 비밀번호가 필요합니다")
+	ErrAccountInvalidPassword        = errors.New("비밀번호가 올바르지 않습니다")
+	ErrAccountDeletionNotScheduled   = errors.New("삭제 예정인 계정이 아닙니다")
+	ErrAccountDeletionCancelled      = errors.New("계정 삭제가 취소되었거나 아직 유예 기간입니다")
+	ErrAccountExportInternal         = errors.New("서버 내부 오류로 계정 데이터 내보내기에 실패했습니다")
+	ErrAccountDeletionScheduleFailed = errors.New("서버 내부 오류로 계정 삭제 예약에 실패했습니다")
+)
@@ -0,0 +1,15 @@
+package apperror
+
+import "errors"
+
+var (
+	ErrAdminUserIDRequired      = errors.New("사용자 ID는 필수입니다")
+	ErrAdminSearchQueryTooLong  = errors.New("검색어는 100자를 넘을 수 없습니다")
+	ErrAdminInvalidStatusFilter = errors.New("상태 필터는 all, active, disabled 중 하나여야 합니다")
+	ErrAdminCannotDisableAdmin  = errors.New("관리자 계정은 비활성화할 수 없습니다")
+	ErrAdminUserDisableFailed   = errors.New("서버 내부 오류로 계정 상태 변경에 실패했습니다")
+	ErrAdminSessionRevokeFailed = errors.New("서버 내부 오류로 세션 폐기에 실패했습니다")
+	ErrAdminInvalidUserID       = errors.New("사용자 ID는 1 이상의 정수여야 합니다")
+	ErrAdminInvalidLockoutScope = errors.New("잠금 범위는 username, ip 중 하나여야 합니다")
+	ErrAdminIdentifierTooLong   = errors.New("잠금 식별자는 255자를 넘을 수 없습니다")
+)
@@ -0,0 +1,9 @@
+package apperror
+
+import "errors"
+
+var (
+	ErrAuditInvalidEventType = errors.New("이벤트 종류는 50자를 넘을 수 없습니다")
+	ErrAuditInvalidUserID    = errors.New("사용자 ID는 1 이상의 정수여야 합니다")
+	ErrAuditInternalServer   = errors.New("서버 내부 오류로 보안 기록 조회에 실패했습니다")
+)
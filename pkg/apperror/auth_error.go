@@ -5,4 +5,15 @@ import "errors"
 var (
 	ErrAuthRequiredToken = errors.New("인증이 필요한 요청입니다")
 	ErrAuthInvalidToken  = errors.New("유효하지 않은 토큰입니다")
+
+	ErrAuthRefreshTokenRequired = errors.New("리프레시 토큰은 필수 입력값입니다")
+	ErrAuthInvalidRefreshToken  = errors.New("유효하지 않은 리프레시 토큰입니다")
+	ErrAuthRefreshTokenReused   = errors.New("이미 사용된 리프레시 토큰입니다. 보안을 위해 다시 로그인해주세요")
+
+	ErrAuthInsufficientScope = errors.New("이 요청을 수행할 권한이 없습니다")
+	ErrAuthReadOnlyAccess    = errors.New("읽기 전용 권한으로는 변경할 수 없습니다")
+	ErrAuthSessionRequired   = errors.New("이 요청은 개인 액세스 토큰이 아닌 로그인 세션으로만 수행할 수 있습니다")
+
+	ErrAuthAdminRequired   = errors.New("관리자만 사용할 수 있는 요청입니다")
+	ErrAuthAccountDisabled = errors.New("비활성화된 계정입니다. 관리자에게 문의해주세요")
 )
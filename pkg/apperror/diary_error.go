@@ -8,7 +8,7 @@ var (
 	ErrDiaryDeleteInternal      = errors.New("서버 내부 오류로 일기 삭제에 실패했습니다")
 	ErrDiaryUpdateInternal      = errors.New("서버 내부 오류로 일기 수정에 실패했습니다")
 	ErrDiaryImageUploadInternal = errors.New("서버 내부 오류로 일기 이미지 업로드에 실패했습니다")
-	ErrDiaryImageGetInternal 		= errors.New("서버 내부 오류로 일기 이미지 조회에 실패했습니다")
+	ErrDiaryImageGetInternal    = errors.New("서버 내부 오류로 일기 이미지 조회에 실패했습니다")
 
 	ErrDiaryCreateTitleRequired   = errors.New("일기 제목은 필수 입력값입니다")
 	ErrDiaryCreateContentRequired = errors.New("일기 내용은 필수 입력값입니다")
@@ -18,6 +18,43 @@ var (
 	ErrDiaryNotFound          = errors.New("해당 일기를 찾을 수 없습니다")
 	ErrDiaryNotFoundOrDeleted = errors.New("해당 일기가 존재하지 않거나 삭제되었습니다")
 	ErrDiaryUpdateForbidden   = errors.New("해당 일기를 수정할 권한이 없습니다")
+	ErrDiaryCategoryNotFound  = errors.New("해당 카테고리를 찾을 수 없습니다")
+	ErrDiaryVersionConflict   = errors.New("다른 곳에서 일기가 먼저 수정되었습니다. 최신 내용을 확인한 뒤 다시 시도해주세요")
 
 	ErrDiaryImageNotFound = errors.New("해당 일기의 이미지를 찾을 수 없습니다")
+
+	ErrDiarySearchQueryRequired  = errors.New("검색어는 필수 입력값입니다")
+	ErrDiarySearchQueryTooLong   = errors.New("검색어는 100자 이하로 입력해주세요")
+	ErrDiarySearchTooManyTerms   = errors.New("검색어와 검색 연산자는 최대 10개까지 입력할 수 있습니다")
+	ErrDiaryInvalidCategoryID    = errors.New("카테고리 ID는 1 이상의 정수여야 합니다")
+	ErrDiaryInvalidDate          = errors.New("날짜는 YYYY-MM-DD 형식이어야 합니다")
+	ErrDiaryInvalidDateRange     = errors.New("시작 날짜는 종료 날짜보다 늦을 수 없습니다")
+	ErrDiaryCalendarInvalidYear  = errors.New("연도는 1 이상 9999 이하의 정수여야 합니다")
+	ErrDiaryCalendarInvalidMonth = errors.New("월은 1 이상 12 이하의 정수여야 합니다")
+	ErrDiaryCalendarInternal     = errors.New("서버 내부 오류로 캘린더 조회에 실패했습니다")
+	ErrDiarySearchInternal       = errors.New("서버 내부 오류로 일기 검색에 실패했습니다")
+
+	ErrDiaryInvalidMood              = errors.New("지원하지 않는 감정입니다")
+	ErrDiaryInvalidMoodIntensity     = errors.New("감정 강도는 1 이상 5 이하의 정수여야 합니다")
+	ErrDiaryMoodIntensityWithoutMood = errors.New("감정 강도는 감정과 함께 입력해야 합니다")
+	ErrDiaryInvalidMoodInterval      = errors.New("집계 단위는 day, week, month 중 하나여야 합니다")
+	ErrDiaryMoodInternal             = errors.New("서버 내부 오류로 감정 조회에 실패했습니다")
+
+	ErrDiaryPatchInvalidBody  = errors.New("부분 수정 요청 본문은 JSON 객체여야 합니다")
+	ErrDiaryPatchEmpty        = errors.New("수정할 항목을 하나 이상 입력해주세요")
+	ErrDiaryPatchUnknownField = errors.New("수정할 수 없는 항목입니다")
+	ErrDiaryPatchInvalidField = errors.New("항목의 값 형식이 올바르지 않습니다")
+
+	ErrDiaryTrashInternal   = errors.New("서버 내부 오류로 휴지통 조회에 실패했습니다")
+	ErrDiaryRestoreInternal = errors.New("서버 내부 오류로 일기 복원에 실패했습니다")
+	ErrDiaryPurgeInternal   = errors.New("서버 내부 오류로 일기 영구 삭제에 실패했습니다")
+	ErrDiaryNotFoundInTrash = errors.New("휴지통에서 해당 일기를 찾을 수 없습니다")
+
+	ErrDiaryRevisionInternal        = errors.New("서버 내부 오류로 일기 수정 기록 조회에 실패했습니다")
+	ErrDiaryRevisionNotFound        = errors.New("해당 수정 기록을 찾을 수 없습니다")
+	ErrDiaryRevisionForbidden       = errors.New("해당 일기의 수정 기록에 접근할 권한이 없습니다")
+	ErrDiaryRevisionInvalidNumber   = errors.New("수정 기록 번호는 1 이상의 정수여야 합니다")
+	ErrDiaryRevisionInvalidDiffMode = errors.New("비교 단위는 line 또는 word여야 합니다")
+	ErrDiaryRevisionNoPrevious      = errors.New("비교할 이전 수정 기록이 없습니다")
+	ErrDiaryRevisionAlreadyCurrent  = errors.New("현재 일기 내용과 같은 수정 기록은 복원할 수 없습니다")
 )
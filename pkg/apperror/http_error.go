@@ -5,4 +5,8 @@ import "errors"
 var (
 	ErrHttpMethodNotAllowed = errors.New("요청 메서드가 허용되지 않습니다")
 	ErrInternalServerError  = errors.New("내부 서버 오류가 발생했습니다")
+
+	ErrHttpInvalidPage   = errors.New("페이지 번호는 1 이상의 정수여야 합니다")
+	ErrHttpInvalidLimit  = errors.New("페이지 크기는 1 이상 100 이하의 정수여야 합니다")
+	ErrHttpInvalidCursor = errors.New("유효하지 않은 페이지 커서입니다")
 )
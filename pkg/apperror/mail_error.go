@@ -0,0 +1,7 @@
+package apperror
+
+import "errors"
+
+var (
+	ErrMailerUnknownDriver = errors.New("지원하지 않는 메일 발송 방식입니다")
+)
@@ -0,0 +1,18 @@
+package apperror
+
+import "errors"
+
+var (
+	ErrMFATokenRequired       = errors.New("2단계 인증 토큰은 필수 입력값입니다")
+	ErrMFAInvalidToken        = errors.New("유효하지 않거나 만료된 2단계 인증 토큰입니다. 다시 로그인해주세요")
+	ErrMFACodeRequired        = errors.New("인증 코드 또는 복구 코드를 입력해주세요")
+	ErrMFAInvalidCode         = errors.New("인증 코드가 올바르지 않습니다")
+	ErrMFACodeAlreadyUsed     = errors.New("이미 사용된 인증 코드입니다. 다음 코드를 입력해주세요")
+	ErrMFATooManyAttempts     = errors.New("인증 시도 횟수를 초과했습니다. 다시 로그인해주세요")
+	ErrMFAAlreadyEnabled      = errors.New("이미 2단계 인증이 활성화되어 있습니다")
+	ErrMFANotEnabled          = errors.New("2단계 인증이 활성화되어 있지 않습니다")
+	ErrMFASetupNotStarted     = errors.New("2단계 인증 등록을 먼저 시작해주세요")
+	ErrMFAPasswordRequired    = errors.New("2단계 인증을 해제하려면 비밀번호가 필요합니다")
+	ErrMFAInvalidPassword     = errors.New("비밀번호가 올바르지 않습니다")
+	ErrMFAInternalServerError = errors.New("서버 내부 오류로 2단계 인증 처리에 실패했습니다")
+)
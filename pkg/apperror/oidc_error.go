@@ -0,0 +1,24 @@
+package apperror
+
+import "errors"
+
+var (
+	ErrOIDCProviderRequired       = errors.New("외부 로그인 제공자는 필수 입력값입니다")
+	ErrOIDCProviderNotFound       = errors.New("지원하지 않는 외부 로그인 제공자입니다")
+	ErrOIDCProviderMisconfigured  = errors.New("외부 로그인 제공자 설정이 올바르지 않습니다")
+	ErrOIDCDiscoveryFailed        = errors.New("외부 로그인 제공자 정보를 가져오지 못했습니다")
+	ErrOIDCStateRequired          = errors.New("state 값은 필수 입력값입니다")
+	ErrOIDCCodeRequired           = errors.New("인가 코드는 필수 입력값입니다")
+	ErrOIDCInvalidState           = errors.New("유효하지 않거나 만료된 외부 로그인 요청입니다. 다시 시도해주세요")
+	ErrOIDCProviderError          = errors.New("외부 로그인 제공자가 인증을 거부했습니다")
+	ErrOIDCTokenExchangeFailed    = errors.New("외부 로그인 제공자에서 토큰을 발급받지 못했습니다")
+	ErrOIDCInvalidIDToken         = errors.New("외부 로그인 제공자의 ID 토큰이 유효하지 않습니다")
+	ErrOIDCUnknownKeyID           = errors.New("ID 토큰 서명 키를 찾을 수 없습니다")
+	ErrOIDCEmailRequired          = errors.New("외부 계정에서 이메일 정보를 가져올 수 없습니다. 이메일 제공에 동의해주세요")
+	ErrOIDCEmailAlreadyRegistered = errors.New("이미 가입된 이메일입니다. 기존 계정으로 로그인한 뒤 프로필에서 외부 계정을 연결해주세요")
+	ErrOIDCIdentityAlreadyLinked  = errors.New("이미 다른 계정에 연결된 외부 계정입니다")
+	ErrOIDCProviderAlreadyLinked  = errors.New("이미 같은 제공자의 외부 계정이 연결되어 있습니다")
+	ErrOIDCIdentityNotFound       = errors.New("연결된 외부 계정을 찾을 수 없습니다")
+	ErrOIDCLastSigninMethod       = errors.New("비밀번호가 없는 계정은 마지막 외부 계정 연결을 해제할 수 없습니다. 먼저 비밀번호를 설정해주세요")
+	ErrOIDCInternalServerError    = errors.New("서버 내부 오류로 외부 로그인 처리에 실패했습니다")
+)
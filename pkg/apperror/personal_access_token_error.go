@@ -0,0 +1,14 @@
+package apperror
+
+import "errors"
+
+var (
+	ErrPersonalAccessTokenNameRequired   = errors.New("토큰 이름은 필수 입력값입니다")
+	ErrPersonalAccessTokenNameTooLong    = errors.New("토큰 이름은 100자를 넘을 수 없습니다")
+	ErrPersonalAccessTokenInvalidScope   = errors.New("토큰 권한은 read 또는 read_write 중 하나여야 합니다")
+	ErrPersonalAccessTokenInvalidExpiry  = errors.New("토큰 만료 시각은 현재 이후여야 합니다")
+	ErrPersonalAccessTokenNotFound       = errors.New("토큰을 찾을 수 없습니다")
+	ErrPersonalAccessTokenIDRequired     = errors.New("토큰 ID는 필수입니다")
+	ErrPersonalAccessTokenLimitExceeded  = errors.New("발급할 수 있는 토큰 개수를 초과했습니다")
+	ErrPersonalAccessTokenInternalServer = errors.New("서버 내부 오류로 토큰 처리에 실패했습니다")
+)
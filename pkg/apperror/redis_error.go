@@ -5,4 +5,14 @@ import "errors"
 var (
 	ErrUserRedisIsNil        = errors.New("세션 서버 연결에 실패했습니다")
 	ErrUserRedisInvalidToken = errors.New("유효하지 않은 세션 토큰입니다")
+
+	ErrUserRedisRefreshFamilyNotFound = errors.New("리프레시 토큰 세션이 존재하지 않습니다")
+	ErrUserRedisRefreshTokenReused    = errors.New("재사용된 리프레시 토큰입니다")
+	ErrUserRedisSessionNotFound       = errors.New("세션이 존재하지 않습니다")
+
+	ErrUserRedisPasswordResetTokenNotFound = errors.New("비밀번호 재설정 토큰이 존재하지 않습니다")
+
+	ErrUserRedisMFAPendingNotFound = errors.New("2단계 인증 대기 정보가 존재하지 않습니다")
+
+	ErrUserRedisOIDCStateNotFound = errors.New("외부 로그인 요청 정보가 존재하지 않습니다")
 )
@@ -0,0 +1,17 @@
+package apperror
+
+import "errors"
+
+var (
+	ErrSearchQueryUnclosedQuote   = errors.New("따옴표가 닫히지 않았습니다")
+	ErrSearchQueryEmptyPhrase     = errors.New("따옴표 안에 검색어가 없습니다")
+	ErrSearchQueryEmptyNegation   = errors.New("제외(-) 뒤에 검색어가 없습니다")
+	ErrSearchQueryUnknownOperator = errors.New("알 수 없는 검색 연산자입니다")
+	ErrSearchQueryEmptyValue      = errors.New("검색 연산자의 값이 비어 있습니다")
+	ErrSearchQueryInvalidDate     = errors.New("날짜는 YYYY-MM-DD 형식이어야 합니다")
+	ErrSearchQueryInvalidHasValue = errors.New("has 연산자는 image, category, mood만 사용할 수 있습니다")
+	ErrSearchQueryCategoryTooLong = errors.New("카테고리 이름은 50자 이하여야 합니다")
+	ErrSearchQueryUnknownMood     = errors.New("지원하지 않는 감정입니다")
+	ErrSearchQueryTagTooLong      = errors.New("태그 이름은 30자 이하여야 합니다")
+	ErrSearchQueryInternal        = errors.New("서버 내부 오류로 검색어 추천에 실패했습니다")
+)
@@ -0,0 +1,22 @@
+package apperror
+
+import "errors"
+
+var (
+	ErrTagGetInternal    = errors.New("서버 내부 오류로 태그 조회에 실패했습니다")
+	ErrTagUpdateInternal = errors.New("서버 내부 오류로 태그 수정에 실패했습니다")
+	ErrTagMergeInternal  = errors.New("서버 내부 오류로 태그 병합에 실패했습니다")
+	ErrTagDeleteInternal = errors.New("서버 내부 오류로 태그 삭제에 실패했습니다")
+
+	ErrTagNameRequired        = errors.New("태그 이름은 필수입니다")
+	ErrTagNameTooLong         = errors.New("태그 이름은 30자 이하여야 합니다")
+	ErrTagNameInvalid         = errors.New("태그 이름에는 쉼표(,)를 사용할 수 없습니다")
+	ErrTagTooMany             = errors.New("일기에는 태그를 최대 10개까지 지정할 수 있습니다")
+	ErrTagIDIsRequired        = errors.New("태그 ID는 필수입니다")
+	ErrTagNotFound            = errors.New("태그를 찾을 수 없습니다")
+	ErrTagNameDuplicate       = errors.New("같은 이름의 태그가 이미 있습니다. 태그 병합을 사용해주세요")
+	ErrTagMergeTargetRequired = errors.New("병합할 대상 태그 ID는 필수입니다")
+	ErrTagMergeSameTag        = errors.New("같은 태그끼리는 병합할 수 없습니다")
+	ErrTagFilterInvalidMode   = errors.New("태그 조건은 all 또는 any여야 합니다")
+	ErrTagFilterTooMany       = errors.New("태그 필터는 최대 10개까지 지정할 수 있습니다")
+)
@@ -6,6 +6,13 @@ var (
 	ErrJWTExpiredToken              = errors.New("로그인 세션이 만료되었습니다")
 	ErrJWTInvalidTokenSigningMethod = errors.New("올바르지 않은 토큰 서명 방법입니다")
 	ErrJWTInvalidRequest            = errors.New("올바르지 않은 요청입니다")
+	ErrJWTUnknownKeyID              = errors.New("알 수 없는 토큰 서명 키입니다")
+
+	ErrJWTSecretRequired    = errors.New("JWT 서명 키가 설정되지 않았습니다. JWT_SECRET 또는 JWT_KEYS_DIR을 설정해주세요")
+	ErrJWTWeakSecret        = errors.New("JWT 서명 키가 너무 약합니다. HS256 비밀 키는 32바이트, RSA 키는 2048비트 이상이어야 합니다")
+	ErrJWTActiveKeyNotFound = errors.New("서명에 사용할 JWT 키(JWT_ACTIVE_KID)를 찾을 수 없거나 개인 키가 아닙니다")
+	ErrJWTInvalidKeyFile    = errors.New("JWT 키 파일 형식이 올바르지 않습니다")
+	ErrJWTDuplicateKeyID    = errors.New("중복된 JWT 키 ID(kid)가 있습니다")
 
 	ErrUserNotFound     = errors.New("사용자를 찾을 수 없습니다")
 	ErrAuthUnauthorized = errors.New("인증되지 않은 요청입니다")
@@ -20,4 +27,40 @@ var (
 
 	ErrUserSigninInvalidUserName = errors.New("사용자 ID가 올바르지 않습니다")
 	ErrUserSigninInvalidPassword = errors.New("비밀번호가 올바르지 않습니다")
+	// 아이디 존재 여부가 드러나지 않도록 로그인 실패는 항상 같은 에러를 반환
+	ErrUserSigninInvalidCredentials = errors.New("아이디 또는 비밀번호가 올바르지 않습니다")
+	ErrUserSigninLocked             = errors.New("로그인 시도가 너무 많습니다. 잠시 후 다시 시도해주세요")
+
+	ErrUserPasswordCurrentRequired = errors.New("현재 비밀번호는 필수 입력값입니다")
+	ErrUserPasswordNewRequired     = errors.New("새 비밀번호는 필수 입력값입니다")
+	ErrUserPasswordInvalidCurrent  = errors.New("현재 비밀번호가 올바르지 않습니다")
+	ErrUserPasswordSameAsCurrent   = errors.New("새 비밀번호는 현재 비밀번호와 달라야 합니다")
+	ErrUserPasswordTooShort        = errors.New("비밀번호는 8자 이상이어야 합니다")
+	ErrUserPasswordTooLong         = errors.New("비밀번호는 72바이트를 넘을 수 없습니다")
+	ErrUserPasswordTooWeak         = errors.New("비밀번호는 영문자와 숫자를 모두 포함해야 합니다")
+	ErrUserPasswordTooCommon       = errors.New("너무 흔하게 사용되는 비밀번호입니다. 다른 비밀번호를 사용해주세요")
+	ErrUserPasswordContainsUser    = errors.New("비밀번호에 아이디나 이메일을 포함할 수 없습니다")
+
+	ErrPasswordHashMismatch      = errors.New("비밀번호가 일치하지 않습니다")
+	ErrPasswordHashInvalidFormat = errors.New("올바르지 않은 비밀번호 해시 형식입니다")
+	ErrPasswordHashUnsupported   = errors.New("지원하지 않는 비밀번호 해시 알고리즘입니다")
+	ErrPasswordHashInvalidConfig = errors.New("비밀번호 해시 설정이 올바르지 않습니다")
+
+	ErrUserPasswordResetEmailRequired = errors.New("이메일은 필수 입력값입니다")
+	ErrUserPasswordResetTokenRequired = errors.New("비밀번호 재설정 토큰은 필수 입력값입니다")
+	ErrUserPasswordResetInvalidToken  = errors.New("유효하지 않거나 만료된 비밀번호 재설정 토큰입니다")
+
+	ErrUserEmailInvalidFormat       = errors.New("올바른 이메일 형식이 아닙니다")
+	ErrUserEmailNotVerified         = errors.New("이메일 인증이 필요합니다. 메일함을 확인해주세요")
+	ErrUserEmailVerifyTokenRequired = errors.New("이메일 인증 토큰은 필수 입력값입니다")
+	ErrUserEmailVerifyInvalidToken  = errors.New("유효하지 않거나 만료된 이메일 인증 토큰입니다")
+
+	ErrUserProfileNothingToUpdate      = errors.New("변경할 프로필 정보가 없습니다")
+	ErrUserProfileNicknameRequired     = errors.New("닉네임은 비어 있을 수 없습니다")
+	ErrUserProfileEmailRequired        = errors.New("이메일은 비어 있을 수 없습니다")
+	ErrUserProfilePasswordRequired     = errors.New("이메일을 변경하려면 현재 비밀번호가 필요합니다")
+	ErrUserProfileInvalidRevisionLimit = errors.New("일기 수정 기록 보관 수는 1 이상 200 이하의 정수여야 합니다")
+
+	ErrUserSessionNotFound   = errors.New("세션을 찾을 수 없습니다")
+	ErrUserSessionIDRequired = errors.New("세션 ID는 필수입니다")
 )
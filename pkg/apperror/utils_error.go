@@ -9,4 +9,6 @@ var (
 	ErrImageInvalidSize                = errors.New("유효하지 않은 이미지 크기입니다")
 
 	ErrEmptySlice = errors.New("빈 슬라이스입니다")
+
+	ErrInvalidTimezone = errors.New("유효하지 않은 시간대입니다 (예: Asia/Seoul)")
 )
@@ -0,0 +1,237 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// 변경 구간 종류
+	DIFF_EQUAL  = "equal"
+	DIFF_INSERT = "insert"
+	DIFF_DELETE = "delete"
+
+	// 편집 거리가 이 값을 넘으면 계산을 멈추고 전체 삭제 후 전체 추가로 처리 ( 메모리 사용량이 편집 거리의 제곱에 비례 )
+	DIFF_MAX_EDIT_DISTANCE = 1000
+)
+
+// DiffOp 구조체는 비교 결과의 연속된 변경 구간 하나입니다. Text는 구간에 포함된 토큰을 이어 붙인 원문입니다.
+type DiffOp struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// DiffResult 구조체는 두 텍스트의 비교 결과입니다. Insertions, Deletions는 추가, 삭제된 토큰(줄 또는 단어) 수입니다.
+type DiffResult struct {
+	Ops        []DiffOp `json:"ops"`
+	Insertions int      `json:"insertions"`
+	Deletions  int      `json:"deletions"`
+}
+
+// diffToken 구조체는 변경 구간으로 묶기 전의 토큰 하나입니다.
+type diffToken struct {
+	kind  string
+	value string
+}
+
+// SplitLines 함수는 텍스트를 줄 단위로 나눕니다. 각 줄은 줄바꿈 문자를 포함하므로 이어 붙이면 원문이 됩니다.
+func SplitLines(text string) []string {
+	lines := []string{}
+	for text != "" {
+		i := strings.IndexByte(text, '\n')
+		if i < 0 {
+			lines = append(lines, text)
+			break
+		}
+		lines = append(lines, text[:i+1])
+		text = text[i+1:]
+	}
+	return lines
+}
+
+// SplitWords 함수는 텍스트를 단어와 공백 구간으로 나눕니다. 공백도 토큰으로 유지하므로 이어 붙이면 원문이 됩니다.
+func SplitWords(text string) []string {
+	words := []string{}
+	start := 0
+	prevSpace := false
+	for i, r := range text {
+		space := unicode.IsSpace(r)
+		if i > start && space != prevSpace {
+			words = append(words, text[start:i])
+			start = i
+		}
+		prevSpace = space
+	}
+	if start < len(text) {
+		words = append(words, text[start:])
+	}
+	return words
+}
+
+// DiffLines 함수는 두 텍스트를 줄 단위로 비교합니다.
+func DiffLines(before string, after string) DiffResult {
+	return Diff(SplitLines(before), SplitLines(after))
+}
+
+// DiffWords 함수는 두 텍스트를 단어 단위로 비교합니다.
+func DiffWords(before string, after string) DiffResult {
+	return Diff(SplitWords(before), SplitWords(after))
+}
+
+// Diff 함수는 두 토큰 목록을 Myers 알고리즘으로 비교하여 최소 변경 구간을 반환합니다.
+// 같은 종류의 연속된 토큰은 하나의 구간으로 묶고, 삭제와 추가가 이어지면 삭제를 먼저 둡니다.
+func Diff(before []string, after []string) DiffResult {
+	// 앞뒤의 공통 구간은 비교 대상에서 제외하여 계산량을 줄임
+	prefix := 0
+	for prefix < len(before) && prefix < len(after) && before[prefix] == after[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(before)-prefix && suffix < len(after)-prefix && before[len(before)-1-suffix] == after[len(after)-1-suffix] {
+		suffix++
+	}
+
+	tokens := []diffToken{}
+	for _, value := range before[:prefix] {
+		tokens = append(tokens, diffToken{kind: DIFF_EQUAL, value: value})
+	}
+	tokens = append(tokens, myersDiff(before[prefix:len(before)-suffix], after[prefix:len(after)-suffix])...)
+	for _, value := range before[len(before)-suffix:] {
+		tokens = append(tokens, diffToken{kind: DIFF_EQUAL, value: value})
+	}
+
+	return groupDiffTokens(tokens)
+}
+
+// myersDiff 함수는 공통 앞뒤 구간을 제외한 두 토큰 목록의 최소 편집 경로를 토큰 순서대로 반환합니다.
+func myersDiff(before []string, after []string) []diffToken {
+	n, m := len(before), len(after)
+	if n == 0 || m == 0 {
+		return replaceDiffTokens(before, after)
+	}
+
+	// v[offset+k]는 대각선 k에서 도달한 가장 먼 x 좌표이며, trace에는 각 단계 시작 시점의 [-d-1, d+1] 구간을 저장
+	max := n + m
+	offset := max + 1
+	v := make([]int, 2*max+3)
+	trace := [][]int{}
+
+	found := false
+	for d := 0; d <= max && !found; d++ {
+		if d > DIFF_MAX_EDIT_DISTANCE {
+			return replaceDiffTokens(before, after)
+		}
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && before[x] == after[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	// 마지막 단계부터 거꾸로 따라가며 경로 복원
+	reversed := []diffToken{}
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		snapshot := trace[d]
+		at := func(k int) int { return snapshot[k+d+1] }
+
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			reversed = append(reversed, diffToken{kind: DIFF_EQUAL, value: before[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				reversed = append(reversed, diffToken{kind: DIFF_INSERT, value: after[y-1]})
+			} else {
+				reversed = append(reversed, diffToken{kind: DIFF_DELETE, value: before[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	tokens := make([]diffToken, len(reversed))
+	for i, token := range reversed {
+		tokens[len(reversed)-1-i] = token
+	}
+	return tokens
+}
+
+// replaceDiffTokens 함수는 이전 토큰을 모두 삭제하고 이후 토큰을 모두 추가하는 변경을 반환합니다.
+func replaceDiffTokens(before []string, after []string) []diffToken {
+	tokens := make([]diffToken, 0, len(before)+len(after))
+	for _, value := range before {
+		tokens = append(tokens, diffToken{kind: DIFF_DELETE, value: value})
+	}
+	for _, value := range after {
+		tokens = append(tokens, diffToken{kind: DIFF_INSERT, value: value})
+	}
+	return tokens
+}
+
+// groupDiffTokens 함수는 토큰 목록을 변경 구간으로 묶고 추가, 삭제된 토큰 수를 셉니다.
+// 변경 사이에 끼어 있는 삭제와 추가는 읽기 쉽도록 삭제를 먼저 모아 둡니다.
+func groupDiffTokens(tokens []diffToken) DiffResult {
+	result := DiffResult{Ops: []DiffOp{}}
+
+	var deleted, inserted []string
+	flush := func() {
+		if len(deleted) > 0 {
+			result.Ops = append(result.Ops, DiffOp{Type: DIFF_DELETE, Text: strings.Join(deleted, "")})
+			result.Deletions += len(deleted)
+		}
+		if len(inserted) > 0 {
+			result.Ops = append(result.Ops, DiffOp{Type: DIFF_INSERT, Text: strings.Join(inserted, "")})
+			result.Insertions += len(inserted)
+		}
+		deleted, inserted = nil, nil
+	}
+
+	var equal []string
+	for _, token := range tokens {
+		switch token.kind {
+		case DIFF_EQUAL:
+			flush()
+			equal = append(equal, token.value)
+			continue
+		case DIFF_DELETE:
+			deleted = append(deleted, token.value)
+		case DIFF_INSERT:
+			inserted = append(inserted, token.value)
+		}
+		if len(equal) > 0 {
+			result.Ops = append(result.Ops, DiffOp{Type: DIFF_EQUAL, Text: strings.Join(equal, "")})
+			equal = nil
+		}
+	}
+	flush()
+	if len(equal) > 0 {
+		result.Ops = append(result.Ops, DiffOp{Type: DIFF_EQUAL, Text: strings.Join(equal, "")})
+	}
+
+	return result
+}
@@ -0,0 +1,246 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name           string
+		before         string
+		after          string
+		wantOps        []DiffOp
+		wantInsertions int
+		wantDeletions  int
+	}{
+		{
+			name:    "둘 다 빈 텍스트",
+			before:  "",
+			after:   "",
+			wantOps: []DiffOp{},
+		},
+		{
+			name:    "변경 없음",
+			before:  "a\nb\n",
+			after:   "a\nb\n",
+			wantOps: []DiffOp{{Type: DIFF_EQUAL, Text: "a\nb\n"}},
+		},
+		{
+			name:   "중간에 줄 추가",
+			before: "a\nc\n",
+			after:  "a\nb\nc\n",
+			wantOps: []DiffOp{
+				{Type: DIFF_EQUAL, Text: "a\n"},
+				{Type: DIFF_INSERT, Text: "b\n"},
+				{Type: DIFF_EQUAL, Text: "c\n"},
+			},
+			wantInsertions: 1,
+		},
+		{
+			name:   "끝의 줄 삭제",
+			before: "a\nb\nc\n",
+			after:  "a\n",
+			wantOps: []DiffOp{
+				{Type: DIFF_EQUAL, Text: "a\n"},
+				{Type: DIFF_DELETE, Text: "b\nc\n"},
+			},
+			wantDeletions: 2,
+		},
+		{
+			name:   "줄 교체는 삭제를 먼저 둠",
+			before: "a\nb\nc\n",
+			after:  "a\nx\ny\nc\n",
+			wantOps: []DiffOp{
+				{Type: DIFF_EQUAL, Text: "a\n"},
+				{Type: DIFF_DELETE, Text: "b\n"},
+				{Type: DIFF_INSERT, Text: "x\ny\n"},
+				{Type: DIFF_EQUAL, Text: "c\n"},
+			},
+			wantInsertions: 2,
+			wantDeletions:  1,
+		},
+		{
+			name:   "빈 텍스트에서 작성",
+			before: "",
+			after:  "a\nb",
+			wantOps: []DiffOp{
+				{Type: DIFF_INSERT, Text: "a\nb"},
+			},
+			wantInsertions: 2,
+		},
+		{
+			name:   "마지막 줄바꿈 추가",
+			before: "a",
+			after:  "a\n",
+			wantOps: []DiffOp{
+				{Type: DIFF_DELETE, Text: "a"},
+				{Type: DIFF_INSERT, Text: "a\n"},
+			},
+			wantInsertions: 1,
+			wantDeletions:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DiffLines(tt.before, tt.after)
+			if !reflect.DeepEqual(result.Ops, tt.wantOps) {
+				t.Errorf("Ops = %q, want %q", result.Ops, tt.wantOps)
+			}
+			if result.Insertions != tt.wantInsertions || result.Deletions != tt.wantDeletions {
+				t.Errorf("(Insertions, Deletions) = (%d, %d), want (%d, %d)", result.Insertions, result.Deletions, tt.wantInsertions, tt.wantDeletions)
+			}
+			assertDiffRestores(t, result, tt.before, tt.after)
+		})
+	}
+}
+
+func TestDiffWords(t *testing.T) {
+	tests := []struct {
+		name    string
+		before  string
+		after   string
+		wantOps []DiffOp
+	}{
+		{
+			name:   "단어 교체",
+			before: "the quick fox",
+			after:  "the slow fox",
+			wantOps: []DiffOp{
+				{Type: DIFF_EQUAL, Text: "the "},
+				{Type: DIFF_DELETE, Text: "quick"},
+				{Type: DIFF_INSERT, Text: "slow"},
+				{Type: DIFF_EQUAL, Text: " fox"},
+			},
+		},
+		{
+			name:   "공백 변경",
+			before: "오늘  날씨",
+			after:  "오늘 날씨",
+			wantOps: []DiffOp{
+				{Type: DIFF_EQUAL, Text: "오늘"},
+				{Type: DIFF_DELETE, Text: "  "},
+				{Type: DIFF_INSERT, Text: " "},
+				{Type: DIFF_EQUAL, Text: "날씨"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := DiffWords(tt.before, tt.after)
+			if !reflect.DeepEqual(result.Ops, tt.wantOps) {
+				t.Errorf("Ops = %q, want %q", result.Ops, tt.wantOps)
+			}
+			assertDiffRestores(t, result, tt.before, tt.after)
+		})
+	}
+}
+
+func TestSplitTokensRestoreText(t *testing.T) {
+	tests := []struct {
+		name      string
+		split     func(string) []string
+		text      string
+		wantCount int
+	}{
+		{name: "줄 단위", split: SplitLines, text: "a\n\nb", wantCount: 3},
+		{name: "줄 단위 마지막 줄바꿈", split: SplitLines, text: "a\nb\n", wantCount: 2},
+		{name: "단어 단위", split: SplitWords, text: " 오늘은\t맑음 \n", wantCount: 5},
+		{name: "빈 텍스트", split: SplitWords, text: "", wantCount: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := tt.split(tt.text)
+			if len(tokens) != tt.wantCount {
+				t.Errorf("len(tokens) = %d, want %d (%q)", len(tokens), tt.wantCount, tokens)
+			}
+			if joined := strings.Join(tokens, ""); joined != tt.text {
+				t.Errorf("joined = %q, want %q", joined, tt.text)
+			}
+		})
+	}
+}
+
+// TestDiffMaxEditDistance는 편집 거리가 DIFF_MAX_EDIT_DISTANCE를 넘을 때만 전체 삭제 후 전체 추가로 처리하는지 확인합니다.
+func TestDiffMaxEditDistance(t *testing.T) {
+	// 같은 줄과 바뀐 줄이 번갈아 나오므로 바뀐 줄 하나마다 편집 거리가 2씩 늘어남
+	build := func(changed int, prefix string) []string {
+		lines := []string{}
+		for i := 0; i < changed; i++ {
+			lines = append(lines, fmt.Sprintf("same %d\n", i), fmt.Sprintf("%s %d\n", prefix, i))
+		}
+		return lines
+	}
+
+	tests := []struct {
+		name         string
+		changed      int
+		wantFallback bool
+	}{
+		{name: "제한 이내", changed: 10, wantFallback: false},
+		{name: "제한과 같은 편집 거리", changed: DIFF_MAX_EDIT_DISTANCE / 2, wantFallback: false},
+		{name: "제한 초과", changed: DIFF_MAX_EDIT_DISTANCE/2 + 1, wantFallback: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := build(tt.changed, "old")
+			after := build(tt.changed, "new")
+			result := Diff(before, after)
+
+			if tt.wantFallback {
+				// 공통 앞부분(첫 줄)만 유지되고 나머지는 한 번에 삭제, 추가
+				wantOps := []DiffOp{
+					{Type: DIFF_EQUAL, Text: before[0]},
+					{Type: DIFF_DELETE, Text: strings.Join(before[1:], "")},
+					{Type: DIFF_INSERT, Text: strings.Join(after[1:], "")},
+				}
+				if !reflect.DeepEqual(result.Ops, wantOps) {
+					t.Errorf("Ops has %d ops, want fallback with 3 ops", len(result.Ops))
+				}
+				if result.Deletions != len(before)-1 || result.Insertions != len(after)-1 {
+					t.Errorf("(Insertions, Deletions) = (%d, %d), want (%d, %d)", result.Insertions, result.Deletions, len(after)-1, len(before)-1)
+				}
+			} else {
+				// 같은 줄은 유지되고 바뀐 줄마다 삭제, 추가 구간이 생김
+				if len(result.Ops) != 3*tt.changed {
+					t.Errorf("len(Ops) = %d, want %d", len(result.Ops), 3*tt.changed)
+				}
+				if result.Deletions != tt.changed || result.Insertions != tt.changed {
+					t.Errorf("(Insertions, Deletions) = (%d, %d), want (%d, %d)", result.Insertions, result.Deletions, tt.changed, tt.changed)
+				}
+			}
+			assertDiffRestores(t, result, strings.Join(before, ""), strings.Join(after, ""))
+		})
+	}
+}
+
+// assertDiffRestores 함수는 변경 구간으로 이전 텍스트(유지+삭제)와 이후 텍스트(유지+추가)를 복원할 수 있는지 확인합니다.
+func assertDiffRestores(t *testing.T, result DiffResult, before string, after string) {
+	t.Helper()
+
+	var restoredBefore, restoredAfter strings.Builder
+	for _, op := range result.Ops {
+		switch op.Type {
+		case DIFF_EQUAL:
+			restoredBefore.WriteString(op.Text)
+			restoredAfter.WriteString(op.Text)
+		case DIFF_DELETE:
+			restoredBefore.WriteString(op.Text)
+		case DIFF_INSERT:
+			restoredAfter.WriteString(op.Text)
+		}
+	}
+
+	if restoredBefore.String() != before {
+		t.Errorf("restored before = %q, want %q", restoredBefore.String(), before)
+	}
+	if restoredAfter.String() != after {
+		t.Errorf("restored after = %q, want %q", restoredAfter.String(), after)
+	}
+}
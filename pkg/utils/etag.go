@@ -0,0 +1,28 @@
+package utils
+
+import "strings"
+
+// MatchIfMatch 함수는 If-Match 헤더 값에 etag가 포함되는지 강한 비교로 확인합니다.
+// "*"는 모든 값과 일치하며, 약한 ETag(W/ 접두사)는 강한 비교에서 일치하지 않습니다.
+func MatchIfMatch(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchIfNoneMatch 함수는 If-None-Match 헤더 값에 etag가 포함되는지 약한 비교로 확인합니다.
+// "*"는 모든 값과 일치하며, W/ 접두사는 무시하고 비교합니다.
+func MatchIfNoneMatch(header string, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package utils
+
+import "testing"
+
+// 서버는 일기 버전으로 만든 강한 ETag를 사용
+const testETag = `"3"`
+
+func TestMatchIfMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   bool
+	}{
+		{name: "같은 ETag", header: `"3"`, want: true},
+		{name: "다른 ETag", header: `"2"`, want: false},
+		{name: "모든 값", header: "*", want: true},
+		{name: "약한 ETag는 강한 비교에서 불일치", header: `W/"3"`, want: false},
+		{name: "목록 중 하나와 일치", header: `"1", "2","3"`, want: true},
+		{name: "목록 중 일치하는 값 없음", header: `"1", W/"3"`, want: false},
+		{name: "목록 안의 모든 값", header: `"1", *`, want: true},
+		{name: "앞뒤 공백", header: `  "3"  `, want: true},
+		{name: "따옴표 없는 값", header: "3", want: false},
+		{name: "빈 헤더", header: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchIfMatch(tt.header, testETag); got != tt.want {
+				t.Errorf("MatchIfMatch(%q, %q) = %v, want %v", tt.header, testETag, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchIfNoneMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		etag   string
+		want   bool
+	}{
+		{name: "같은 ETag", header: `"3"`, etag: testETag, want: true},
+		{name: "다른 ETag", header: `"2"`, etag: testETag, want: false},
+		{name: "모든 값", header: "*", etag: testETag, want: true},
+		{name: "약한 ETag도 약한 비교에서 일치", header: `W/"3"`, etag: testETag, want: true},
+		{name: "서버 ETag가 약한 ETag", header: `"3"`, etag: `W/"3"`, want: true},
+		{name: "목록 중 하나와 일치", header: `"1", W/"3"`, etag: testETag, want: true},
+		{name: "목록 중 일치하는 값 없음", header: `"1",W/"2"`, etag: testETag, want: false},
+		{name: "따옴표 없는 값", header: "W/3", etag: testETag, want: false},
+		{name: "빈 헤더", header: "", etag: testETag, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchIfNoneMatch(tt.header, tt.etag); got != tt.want {
+				t.Errorf("MatchIfNoneMatch(%q, %q) = %v, want %v", tt.header, tt.etag, got, tt.want)
+			}
+		})
+	}
+}
@@ -53,3 +53,14 @@ func ReadFile(path string) ([]byte, error) {
 
 	return data, nil
 }
+
+// RemoveFiles 함수는 여러 파일을 삭제합니다. 이미 없는 파일은 무시하고, 실패한 항목이 있어도 나머지는 계속 삭제합니다.
+func RemoveFiles(paths []string) error {
+	var errs []error
+	for _, path := range paths {
+		if err := removeFile(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	HIGHLIGHT_START    = "<mark>"  // 검색어 일치 구간 시작 태그
+	HIGHLIGHT_END      = "</mark>" // 검색어 일치 구간 종료 태그
+	HIGHLIGHT_ELLIPSIS = "…"       // 잘린 스니펫 앞뒤에 붙는 문자
+)
+
+// highlightRange 구조체는 검색어와 일치하는 rune 구간 [start, end)입니다.
+type highlightRange struct {
+	start int
+	end   int
+}
+
+// findHighlightRanges 함수는 대소문자를 구분하지 않고 검색어와 일치하는 구간을 겹치지 않게 찾습니다.
+// 같은 위치에서 여러 검색어가 일치하면 가장 긴 검색어를 사용합니다.
+func findHighlightRanges(text []rune, terms []string) []highlightRange {
+	lowerText := make([]rune, len(text))
+	for i, r := range text {
+		lowerText[i] = unicode.ToLower(r)
+	}
+
+	lowerTerms := make([][]rune, 0, len(terms))
+	for _, term := range terms {
+		if term == "" {
+			continue
+		}
+		lowerTerms = append(lowerTerms, []rune(strings.ToLower(term)))
+	}
+
+	ranges := []highlightRange{}
+	for i := 0; i < len(lowerText); {
+		matched := 0
+		for _, term := range lowerTerms {
+			if len(term) > matched && i+len(term) <= len(lowerText) && string(lowerText[i:i+len(term)]) == string(term) {
+				matched = len(term)
+			}
+		}
+		if matched == 0 {
+			i++
+			continue
+		}
+		ranges = append(ranges, highlightRange{start: i, end: i + matched})
+		i += matched
+	}
+
+	return ranges
+}
+
+// writeHighlighted 함수는 text[start:end]를 HTML 이스케이프하여 쓰고 일치 구간을 강조 태그로 감쌉니다.
+// 줄바꿈 등의 공백 문자는 한 줄로 보이도록 공백으로 바꿉니다.
+func writeHighlighted(builder *strings.Builder, text []rune, ranges []highlightRange, start int, end int) {
+	plain := func(from int, to int) string {
+		segment := make([]rune, 0, to-from)
+		for _, r := range text[from:to] {
+			if unicode.IsSpace(r) {
+				r = ' '
+			}
+			segment = append(segment, r)
+		}
+		return html.EscapeString(string(segment))
+	}
+
+	cursor := start
+	for _, hr := range ranges {
+		if hr.end <= start || hr.start >= end {
+			continue
+		}
+		from, to := max(hr.start, start), min(hr.end, end)
+		builder.WriteString(plain(cursor, from))
+		builder.WriteString(HIGHLIGHT_START)
+		builder.WriteString(plain(from, to))
+		builder.WriteString(HIGHLIGHT_END)
+		cursor = to
+	}
+	builder.WriteString(plain(cursor, end))
+}
+
+// HighlightTerms 함수는 문자열 전체를 HTML 이스케이프하고 검색어와 일치하는 부분을 <mark> 태그로 감쌉니다.
+func HighlightTerms(text string, terms []string) string {
+	runes := []rune(text)
+	builder := &strings.Builder{}
+	writeHighlighted(builder, runes, findHighlightRanges(runes, terms), 0, len(runes))
+	return builder.String()
+}
+
+// HighlightSnippet 함수는 첫 번째 검색어 일치 위치의 앞뒤 radius 글자를 잘라 강조된 스니펫을 만듭니다.
+// 일치하는 부분이 없으면 앞부분을 잘라 반환하며, 잘린 쪽에는 말줄임표를 붙입니다.
+func HighlightSnippet(text string, terms []string, radius int) string {
+	runes := []rune(text)
+	ranges := findHighlightRanges(runes, terms)
+
+	start, end := 0, min(len(runes), radius*2)
+	if len(ranges) > 0 {
+		start = max(0, ranges[0].start-radius)
+		end = min(len(runes), ranges[0].end+radius)
+	}
+
+	builder := &strings.Builder{}
+	if start > 0 {
+		builder.WriteString(HIGHLIGHT_ELLIPSIS)
+	}
+	writeHighlighted(builder, runes, ranges, start, end)
+	if end < len(runes) {
+		builder.WriteString(HIGHLIGHT_ELLIPSIS)
+	}
+
+	return strings.TrimSpace(builder.String())
+}
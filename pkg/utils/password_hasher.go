@@ -0,0 +1,277 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/jhphon0730/dairify/internal/config"
+	"github.com/jhphon0730/dairify/pkg/apperror"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	ARGON2_SALT_BYTES = 16 // argon2id salt 길이
+	ARGON2_KEY_BYTES  = 32 // argon2id 해시 길이
+
+	ARGON2ID_HASH_PREFIX = "$argon2id$"
+)
+
+// PasswordHasher 인터페이스는 비밀번호 해시 알고리즘 하나를 나타냅니다.
+// 해시는 알고리즘과 파라미터를 함께 담은 PHC 문자열 형식으로 저장합니다.
+type PasswordHasher interface {
+	// Hash 함수는 비밀번호를 해시화하여 PHC 문자열로 반환합니다.
+	Hash(password string) (string, error)
+	// Compare 함수는 비밀번호가 해시와 일치하는지 확인합니다. 일치하지 않으면 ErrPasswordHashMismatch를 반환합니다.
+	Compare(encodedHash, password string) error
+	// Identify 함수는 해시가 이 알고리즘으로 만들어졌는지 확인합니다.
+	Identify(encodedHash string) bool
+	// NeedsRehash 함수는 해시가 다른 알고리즘이거나 현재 설정보다 약한 파라미터로 만들어졌는지 확인합니다.
+	NeedsRehash(encodedHash string) bool
+}
+
+// argon2idHasher 구조체는 argon2id 알고리즘으로 PasswordHasher 인터페이스를 구현합니다.
+type argon2idHasher struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// NewArgon2idHasher 함수는 argon2id PasswordHasher를 반환합니다. memory는 KiB 단위입니다.
+func NewArgon2idHasher(memory, iterations uint32, parallelism uint8) (PasswordHasher, error) {
+	if memory < 8*uint32(parallelism) || iterations == 0 || parallelism == 0 {
+		return nil, apperror.ErrPasswordHashInvalidConfig
+	}
+	return &argon2idHasher{memory: memory, iterations: iterations, parallelism: parallelism}, nil
+}
+
+// argon2idParams 구조체는 PHC 문자열에서 읽은 argon2id 파라미터입니다.
+type argon2idParams struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+}
+
+// Hash 함수는 임의의 salt로 비밀번호를 해시화하여
+// $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<hash> 형식으로 반환합니다.
+func (h *argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, ARGON2_SALT_BYTES)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, h.iterations, h.memory, h.parallelism, ARGON2_KEY_BYTES)
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		ARGON2ID_HASH_PREFIX, argon2.Version, h.memory, h.iterations, h.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Compare 함수는 해시에 기록된 파라미터로 비밀번호를 다시 해시화하여 비교합니다.
+func (h *argon2idHasher) Compare(encodedHash, password string) error {
+	params, err := parseArgon2idHash(encodedHash)
+	if err != nil {
+		return err
+	}
+
+	key := argon2.IDKey([]byte(password), params.salt, params.iterations, params.memory, params.parallelism, uint32(len(params.key)))
+	if subtle.ConstantTimeCompare(key, params.key) != 1 {
+		return apperror.ErrPasswordHashMismatch
+	}
+	return nil
+}
+
+// Identify 함수는 해시가 argon2id PHC 문자열인지 확인합니다.
+func (h *argon2idHasher) Identify(encodedHash string) bool {
+	return strings.HasPrefix(encodedHash, ARGON2ID_HASH_PREFIX)
+}
+
+// NeedsRehash 함수는 해시의 파라미터가 현재 설정과 다른지 확인합니다.
+func (h *argon2idHasher) NeedsRehash(encodedHash string) bool {
+	params, err := parseArgon2idHash(encodedHash)
+	if err != nil {
+		return true
+	}
+	return params.memory != h.memory ||
+		params.iterations != h.iterations ||
+		params.parallelism != h.parallelism ||
+		len(params.salt) != ARGON2_SALT_BYTES ||
+		len(params.key) != ARGON2_KEY_BYTES
+}
+
+// parseArgon2idHash 함수는 argon2id PHC 문자열에서 파라미터, salt, 해시를 읽습니다.
+func parseArgon2idHash(encodedHash string) (*argon2idParams, error) {
+	// "", "argon2id", "v=19", "m=..,t=..,p=..", salt, hash
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 || parts[1] != config.PASSWORD_HASH_ARGON2ID {
+		return nil, apperror.ErrPasswordHashInvalidFormat
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, apperror.ErrPasswordHashInvalidFormat
+	}
+	if version != argon2.Version {
+		return nil, apperror.ErrPasswordHashUnsupported
+	}
+
+	params := &argon2idParams{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return nil, apperror.ErrPasswordHashInvalidFormat
+	}
+	if params.iterations == 0 || params.parallelism == 0 {
+		return nil, apperror.ErrPasswordHashInvalidFormat
+	}
+
+	var err error
+	if params.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, apperror.ErrPasswordHashInvalidFormat
+	}
+	if params.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(params.key) == 0 {
+		return nil, apperror.ErrPasswordHashInvalidFormat
+	}
+
+	return params, nil
+}
+
+// bcryptHasher 구조체는 bcrypt 알고리즘으로 PasswordHasher 인터페이스를 구현합니다.
+// bcrypt 해시($2a$, $2b$, $2y$)는 PHC 형식의 바탕이 된 modular crypt 형식을 그대로 사용합니다.
+type bcryptHasher struct {
+	cost int
+}
+
+// NewBcryptHasher 함수는 bcrypt PasswordHasher를 반환합니다.
+func NewBcryptHasher(cost int) (PasswordHasher, error) {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return nil, apperror.ErrPasswordHashInvalidConfig
+	}
+	return &bcryptHasher{cost: cost}, nil
+}
+
+// Hash 함수는 비밀번호를 bcrypt로 해시화합니다.
+func (h *bcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// Compare 함수는 비밀번호가 bcrypt 해시와 일치하는지 확인합니다.
+func (h *bcryptHasher) Compare(encodedHash, password string) error {
+	err := bcrypt.CompareHashAndPassword([]byte(encodedHash), []byte(password))
+	if err == bcrypt.ErrMismatchedHashAndPassword {
+		return apperror.ErrPasswordHashMismatch
+	}
+	if err != nil {
+		return apperror.ErrPasswordHashInvalidFormat
+	}
+	return nil
+}
+
+// Identify 함수는 해시가 bcrypt 해시인지 확인합니다.
+func (h *bcryptHasher) Identify(encodedHash string) bool {
+	return strings.HasPrefix(encodedHash, "$2a$") ||
+		strings.HasPrefix(encodedHash, "$2b$") ||
+		strings.HasPrefix(encodedHash, "$2y$")
+}
+
+// NeedsRehash 함수는 해시의 cost가 현재 설정과 다른지 확인합니다.
+func (h *bcryptHasher) NeedsRehash(encodedHash string) bool {
+	cost, err := bcrypt.Cost([]byte(encodedHash))
+	return err != nil || cost != h.cost
+}
+
+// passwordHashers 구조체는 새 해시에 사용할 알고리즘과 기존 해시 검증에 사용할 전체 알고리즘 목록입니다.
+type passwordHashers struct {
+	preferred PasswordHasher
+	all       []PasswordHasher
+}
+
+var (
+	password_hashers_once     sync.Once
+	password_hashers_instance *passwordHashers
+	password_hashers_err      error
+)
+
+// LoadPasswordHasher 함수는 설정된 알고리즘의 PasswordHasher를 반환합니다. 서버 시작 시 호출하여 설정 오류를 미리 확인합니다.
+func LoadPasswordHasher() (PasswordHasher, error) {
+	hashers, err := loadPasswordHashers()
+	if err != nil {
+		return nil, err
+	}
+	return hashers.preferred, nil
+}
+
+// loadPasswordHashers 함수는 설정에서 비밀번호 해시 알고리즘 목록을 한 번만 구성합니다.
+func loadPasswordHashers() (*passwordHashers, error) {
+	password_hashers_once.Do(func() {
+		cfg := config.GetConfig().PasswordHash
+
+		argon2idPasswordHasher, err := NewArgon2idHasher(cfg.Argon2Memory, cfg.Argon2Iterations, cfg.Argon2Parallelism)
+		if err != nil {
+			password_hashers_err = err
+			return
+		}
+		bcryptPasswordHasher, err := NewBcryptHasher(cfg.BcryptCost)
+		if err != nil {
+			password_hashers_err = err
+			return
+		}
+
+		hashers := &passwordHashers{all: []PasswordHasher{argon2idPasswordHasher, bcryptPasswordHasher}}
+		switch cfg.Algorithm {
+		case config.PASSWORD_HASH_ARGON2ID:
+			hashers.preferred = argon2idPasswordHasher
+		case config.PASSWORD_HASH_BCRYPT:
+			hashers.preferred = bcryptPasswordHasher
+		default:
+			password_hashers_err = apperror.ErrPasswordHashUnsupported
+			return
+		}
+		password_hashers_instance = hashers
+	})
+	return password_hashers_instance, password_hashers_err
+}
+
+// GenerateHashPassword 함수는 설정된 알고리즘으로 비밀번호를 해시화하여 반환합니다.
+func GenerateHashPassword(password string) (string, error) {
+	hasher, err := LoadPasswordHasher()
+	if err != nil {
+		return "", err
+	}
+	return hasher.Hash(password)
+}
+
+// CompareHashAndPassword 함수는 해시의 알고리즘을 판별하여 주어진 비밀번호와 비교합니다.
+// 설정된 알고리즘과 다른 알고리즘으로 저장된 기존 해시도 검증할 수 있습니다.
+func CompareHashAndPassword(hashedPassword, password string) error {
+	hashers, err := loadPasswordHashers()
+	if err != nil {
+		return err
+	}
+
+	for _, hasher := range hashers.all {
+		if hasher.Identify(hashedPassword) {
+			return hasher.Compare(hashedPassword, password)
+		}
+	}
+	return apperror.ErrPasswordHashUnsupported
+}
+
+// PasswordNeedsRehash 함수는 저장된 해시를 현재 설정된 알고리즘과 파라미터로 다시 해시화해야 하는지 확인합니다.
+func PasswordNeedsRehash(hashedPassword string) bool {
+	hasher, err := LoadPasswordHasher()
+	if err != nil {
+		return false
+	}
+	return !hasher.Identify(hashedPassword) || hasher.NeedsRehash(hashedPassword)
+}
@@ -0,0 +1,279 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jhphon0730/dairify/pkg/apperror"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 테스트 속도를 위해 가장 약한 argon2id 파라미터를 사용
+const (
+	testArgon2Memory      = 64
+	testArgon2Iterations  = 1
+	testArgon2Parallelism = 1
+)
+
+// newTestHashers 함수는 테스트용 파라미터로 argon2id, bcrypt PasswordHasher를 만듭니다.
+func newTestHashers(t *testing.T) (PasswordHasher, PasswordHasher) {
+	t.Helper()
+
+	argon2idPasswordHasher, err := NewArgon2idHasher(testArgon2Memory, testArgon2Iterations, testArgon2Parallelism)
+	if err != nil {
+		t.Fatalf("NewArgon2idHasher() error = %v", err)
+	}
+	bcryptPasswordHasher, err := NewBcryptHasher(bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("NewBcryptHasher() error = %v", err)
+	}
+	return argon2idPasswordHasher, bcryptPasswordHasher
+}
+
+// useTestPasswordHashers 함수는 설정 파일을 읽지 않도록 패키지 전역 PasswordHasher 목록을 테스트용으로 채웁니다.
+func useTestPasswordHashers(t *testing.T) {
+	t.Helper()
+
+	argon2idPasswordHasher, bcryptPasswordHasher := newTestHashers(t)
+	password_hashers_once.Do(func() {
+		password_hashers_instance = &passwordHashers{
+			preferred: argon2idPasswordHasher,
+			all:       []PasswordHasher{argon2idPasswordHasher, bcryptPasswordHasher},
+		}
+	})
+}
+
+func TestPasswordHasherRoundTrip(t *testing.T) {
+	argon2idPasswordHasher, bcryptPasswordHasher := newTestHashers(t)
+
+	tests := []struct {
+		name       string
+		hasher     PasswordHasher
+		wantPrefix string
+	}{
+		{name: "argon2id", hasher: argon2idPasswordHasher, wantPrefix: ARGON2ID_HASH_PREFIX},
+		{name: "bcrypt", hasher: bcryptPasswordHasher, wantPrefix: "$2a$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := tt.hasher.Hash("correct horse")
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if !strings.HasPrefix(hash, tt.wantPrefix) {
+				t.Errorf("Hash() = %q, want prefix %q", hash, tt.wantPrefix)
+			}
+			if !tt.hasher.Identify(hash) {
+				t.Errorf("Identify(%q) = false, want true", hash)
+			}
+
+			if err := tt.hasher.Compare(hash, "correct horse"); err != nil {
+				t.Errorf("Compare(correct) error = %v", err)
+			}
+			if err := tt.hasher.Compare(hash, "wrong horse"); !errors.Is(err, apperror.ErrPasswordHashMismatch) {
+				t.Errorf("Compare(wrong) error = %v, want %v", err, apperror.ErrPasswordHashMismatch)
+			}
+
+			// 같은 비밀번호라도 salt가 달라 해시가 달라짐
+			other, err := tt.hasher.Hash("correct horse")
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if other == hash {
+				t.Errorf("two hashes of the same password are equal")
+			}
+		})
+	}
+}
+
+func TestPasswordHasherIdentify(t *testing.T) {
+	argon2idPasswordHasher, bcryptPasswordHasher := newTestHashers(t)
+
+	argon2idHash, err := argon2idPasswordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bcryptHash, err := bcryptPasswordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		hash         string
+		wantArgon2id bool
+		wantBcrypt   bool
+	}{
+		{name: "argon2id 해시", hash: argon2idHash, wantArgon2id: true},
+		{name: "bcrypt 해시", hash: bcryptHash, wantBcrypt: true},
+		{name: "$2b$ bcrypt 해시", hash: "$2b$" + bcryptHash[4:], wantBcrypt: true},
+		{name: "argon2i 해시", hash: "$argon2i$v=19$m=64,t=1,p=1$c2FsdA$a2V5"},
+		{name: "빈 해시"},
+		{name: "평문", hash: "password"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := argon2idPasswordHasher.Identify(tt.hash); got != tt.wantArgon2id {
+				t.Errorf("argon2id Identify(%q) = %v, want %v", tt.hash, got, tt.wantArgon2id)
+			}
+			if got := bcryptPasswordHasher.Identify(tt.hash); got != tt.wantBcrypt {
+				t.Errorf("bcrypt Identify(%q) = %v, want %v", tt.hash, got, tt.wantBcrypt)
+			}
+		})
+	}
+}
+
+func TestPasswordHasherNeedsRehash(t *testing.T) {
+	argon2idPasswordHasher, bcryptPasswordHasher := newTestHashers(t)
+
+	stronger, err := NewArgon2idHasher(testArgon2Memory*2, testArgon2Iterations, testArgon2Parallelism)
+	if err != nil {
+		t.Fatal(err)
+	}
+	strongerHash, err := stronger.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	argon2idHash, err := argon2idPasswordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bcryptHash, err := bcryptPasswordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	higherCostHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		hasher PasswordHasher
+		hash   string
+		want   bool
+	}{
+		{name: "argon2id 같은 파라미터", hasher: argon2idPasswordHasher, hash: argon2idHash, want: false},
+		{name: "argon2id 다른 memory", hasher: argon2idPasswordHasher, hash: strongerHash, want: true},
+		{name: "argon2id 잘못된 형식", hasher: argon2idPasswordHasher, hash: ARGON2ID_HASH_PREFIX + "garbage", want: true},
+		{name: "argon2id 빈 해시", hasher: argon2idPasswordHasher, hash: "", want: true},
+		{name: "bcrypt 같은 cost", hasher: bcryptPasswordHasher, hash: bcryptHash, want: false},
+		{name: "bcrypt 다른 cost", hasher: bcryptPasswordHasher, hash: string(higherCostHash), want: true},
+		{name: "bcrypt 잘못된 형식", hasher: bcryptPasswordHasher, hash: "$2a$garbage", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.hasher.NeedsRehash(tt.hash); got != tt.want {
+				t.Errorf("NeedsRehash(%q) = %v, want %v", tt.hash, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPasswordHasherInvalidFormat(t *testing.T) {
+	argon2idPasswordHasher, _ := newTestHashers(t)
+
+	tests := []struct {
+		name    string
+		hash    string
+		wantErr error
+	}{
+		{name: "필드 부족", hash: "$argon2id$v=19$m=64,t=1,p=1$c2FsdA", wantErr: apperror.ErrPasswordHashInvalidFormat},
+		{name: "잘못된 파라미터", hash: "$argon2id$v=19$m=x,t=1,p=1$c2FsdA$a2V5", wantErr: apperror.ErrPasswordHashInvalidFormat},
+		{name: "잘못된 base64", hash: "$argon2id$v=19$m=64,t=1,p=1$!!!$a2V5", wantErr: apperror.ErrPasswordHashInvalidFormat},
+		{name: "다른 버전", hash: "$argon2id$v=16$m=64,t=1,p=1$c2FsdA$a2V5", wantErr: apperror.ErrPasswordHashUnsupported},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := argon2idPasswordHasher.Compare(tt.hash, "password"); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Compare(%q) error = %v, want %v", tt.hash, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCompareHashAndPassword(t *testing.T) {
+	useTestPasswordHashers(t)
+	_, bcryptPasswordHasher := newTestHashers(t)
+
+	// 설정된 알고리즘이 argon2id여도 기존 bcrypt 해시를 검증
+	bcryptHash, err := bcryptPasswordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	argon2idHash, err := GenerateHashPassword("password")
+	if err != nil {
+		t.Fatalf("GenerateHashPassword() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		hash     string
+		password string
+		wantErr  error
+	}{
+		{name: "argon2id 일치", hash: argon2idHash, password: "password"},
+		{name: "bcrypt 일치", hash: bcryptHash, password: "password"},
+		{name: "bcrypt 불일치", hash: bcryptHash, password: "wrong", wantErr: apperror.ErrPasswordHashMismatch},
+		{name: "빈 해시", hash: "", password: "password", wantErr: apperror.ErrPasswordHashUnsupported},
+		{name: "빈 해시와 빈 비밀번호", hash: "", password: "", wantErr: apperror.ErrPasswordHashUnsupported},
+		{name: "알 수 없는 알고리즘", hash: "$scrypt$ln=16,r=8,p=1$c2FsdA$a2V5", password: "password", wantErr: apperror.ErrPasswordHashUnsupported},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CompareHashAndPassword(tt.hash, tt.password); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CompareHashAndPassword(%q) error = %v, want %v", tt.hash, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPasswordNeedsRehash(t *testing.T) {
+	useTestPasswordHashers(t)
+	_, bcryptPasswordHasher := newTestHashers(t)
+
+	argon2idHash, err := GenerateHashPassword("password")
+	if err != nil {
+		t.Fatalf("GenerateHashPassword() error = %v", err)
+	}
+	bcryptHash, err := bcryptPasswordHasher.Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 설정된 알고리즘이 아닌 해시는 파라미터와 관계없이 다시 해시화 대상
+	if PasswordNeedsRehash(argon2idHash) {
+		t.Errorf("PasswordNeedsRehash(argon2id) = true, want false")
+	}
+	if !PasswordNeedsRehash(bcryptHash) {
+		t.Errorf("PasswordNeedsRehash(bcrypt) = false, want true")
+	}
+}
+
+func TestNewPasswordHasherInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		new  func() (PasswordHasher, error)
+	}{
+		{name: "argon2id memory 부족", new: func() (PasswordHasher, error) { return NewArgon2idHasher(15, 1, 2) }},
+		{name: "argon2id iterations 0", new: func() (PasswordHasher, error) { return NewArgon2idHasher(64, 0, 1) }},
+		{name: "argon2id parallelism 0", new: func() (PasswordHasher, error) { return NewArgon2idHasher(64, 1, 0) }},
+		{name: "bcrypt 낮은 cost", new: func() (PasswordHasher, error) { return NewBcryptHasher(bcrypt.MinCost - 1) }},
+		{name: "bcrypt 높은 cost", new: func() (PasswordHasher, error) { return NewBcryptHasher(bcrypt.MaxCost + 1) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.new(); !errors.Is(err, apperror.ErrPasswordHashInvalidConfig) {
+				t.Errorf("error = %v, want %v", err, apperror.ErrPasswordHashInvalidConfig)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/jhphon0730/dairify/internal/config"
+)
+
+// GetClientIP 함수는 요청한 클라이언트의 IP 주소를 반환합니다.
+// 프록시 헤더는 TRUST_PROXY 설정이 켜진 경우에만 사용합니다.
+func GetClientIP(r *http.Request) string {
+	if config.GetConfig().TRUST_PROXY {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			// 첫 번째 값이 최초 클라이언트 IP
+			return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+		if realIP := r.Header.Get("X-Real-IP"); realIP != "" {
+			return strings.TrimSpace(realIP)
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"strings"
+	"time"
+	_ "time/tzdata" // 시간대 데이터가 없는 컨테이너에서도 IANA 시간대를 사용할 수 있도록 포함
+
+	"github.com/jhphon0730/dairify/pkg/apperror"
+)
+
+// LoadTimezone 함수는 IANA 시간대 이름(예: Asia/Seoul)으로 시간대를 불러옵니다.
+// 서버 환경에 따라 의미가 달라지는 빈 값과 "Local"은 허용하지 않습니다.
+func LoadTimezone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || name == "Local" {
+		return nil, apperror.ErrInvalidTimezone
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, apperror.ErrInvalidTimezone
+	}
+	return location, nil
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	SECURE_TOKEN_BYTES = 32 // 메일 등으로 전달하는 일회용 토큰의 바이트 길이
+
+	RECOVERY_CODE_BYTES      = 10 // 복구 코드 원본 바이트 길이 (base32 16자)
+	RECOVERY_CODE_GROUP_SIZE = 4  // 사람이 읽기 쉽도록 나누는 글자 수
+)
+
+// GenerateSecureToken 함수는 URL에 안전한 무작위 토큰 문자열을 생성합니다.
+func GenerateSecureToken() (string, error) {
+	b := make([]byte, SECURE_TOKEN_BYTES)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken 함수는 토큰을 저장소에 보관하기 위해 SHA-256 해시(hex)로 변환합니다.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateRecoveryCode 함수는 사람이 옮겨 적기 쉬운 형식(xxxx-xxxx-xxxx-xxxx)의 복구 코드를 생성합니다.
+func GenerateRecoveryCode() (string, error) {
+	b := make([]byte, RECOVERY_CODE_BYTES)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	raw := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b))
+
+	groups := make([]string, 0, len(raw)/RECOVERY_CODE_GROUP_SIZE)
+	for i := 0; i < len(raw); i += RECOVERY_CODE_GROUP_SIZE {
+		groups = append(groups, raw[i:min(i+RECOVERY_CODE_GROUP_SIZE, len(raw))])
+	}
+	return strings.Join(groups, "-"), nil
+}
+
+// NormalizeRecoveryCode 함수는 입력된 복구 코드에서 구분자와 공백을 제거하고 소문자로 변환합니다.
+// 저장 및 비교 시 항상 이 함수를 거친 값을 해시합니다.
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+}
@@ -1,12 +1,33 @@
 package utils
 
 import (
+	_ "embed"
 	"net/http"
+	"net/mail"
 	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/jhphon0730/dairify/pkg/apperror"
 )
 
+const (
+	// 비밀번호 정책
+	PASSWORD_MIN_LENGTH = 8  // 최소 글자 수
+	PASSWORD_MAX_BYTES  = 72 // bcrypt 입력 최대 바이트 수
+
+	PASSWORD_USER_INFO_MIN_LENGTH = 3 // 비밀번호 포함 여부를 검사할 아이디, 이메일의 최소 글자 수
+)
+
+//go:embed common_passwords.txt
+var commonPasswordsFile string
+
+var (
+	commonPasswordsOnce sync.Once
+	commonPasswords     map[string]struct{}
+)
+
 // ValidateImageUpload는 이미지 업로드 요청의 유효성을 검사합니다.
 func ValidateImageUpload(r *http.Request) error {
 	if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
@@ -14,3 +35,90 @@ func ValidateImageUpload(r *http.Request) error {
 	}
 	return nil
 }
+
+// ValidatePasswordPolicy는 비밀번호가 최소 보안 정책을 만족하는지 검사합니다.
+// userInputs에 아이디, 이메일 등을 전달하면 비밀번호에 해당 값이 포함되었는지도 검사합니다.
+func ValidatePasswordPolicy(password string, userInputs ...string) error {
+	if utf8.RuneCountInString(password) < PASSWORD_MIN_LENGTH {
+		return apperror.ErrUserPasswordTooShort
+	}
+	// bcrypt는 72바이트 이후를 무시하므로 초과 입력을 거부
+	if len(password) > PASSWORD_MAX_BYTES {
+		return apperror.ErrUserPasswordTooLong
+	}
+
+	hasLetter, hasDigit := false, false
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	if !hasLetter || !hasDigit {
+		return apperror.ErrUserPasswordTooWeak
+	}
+
+	if IsCommonPassword(password) {
+		return apperror.ErrUserPasswordTooCommon
+	}
+	if containsUserInput(password, userInputs) {
+		return apperror.ErrUserPasswordContainsUser
+	}
+
+	return nil
+}
+
+// IsCommonPassword는 비밀번호가 흔하게 사용되는 비밀번호 목록에 있는지 확인합니다.
+// 대소문자를 구분하지 않으며, 끝에 붙은 숫자와 기호를 제거한 값(예: dragon2024! -> dragon)도 함께 비교합니다.
+func IsCommonPassword(password string) bool {
+	commonPasswordsOnce.Do(func() {
+		commonPasswords = map[string]struct{}{}
+		for _, line := range strings.Split(commonPasswordsFile, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			commonPasswords[strings.ToLower(line)] = struct{}{}
+		}
+	})
+
+	lower := strings.ToLower(password)
+	if _, ok := commonPasswords[lower]; ok {
+		return true
+	}
+
+	stem := strings.TrimRightFunc(lower, func(r rune) bool {
+		return unicode.IsDigit(r) || unicode.IsPunct(r) || unicode.IsSymbol(r)
+	})
+	_, ok := commonPasswords[stem]
+	return ok
+}
+
+// containsUserInput는 비밀번호에 아이디나 이메일(@ 앞부분)이 대소문자 구분 없이 포함되어 있는지 확인합니다.
+func containsUserInput(password string, userInputs []string) bool {
+	lower := strings.ToLower(password)
+	for _, input := range userInputs {
+		input = strings.ToLower(strings.TrimSpace(input))
+		if at := strings.Index(input, "@"); at >= 0 {
+			input = input[:at]
+		}
+		if utf8.RuneCountInString(input) < PASSWORD_USER_INFO_MIN_LENGTH {
+			continue
+		}
+		if strings.Contains(lower, input) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidEmail는 문자열이 표시 이름 없는 단일 이메일 주소 형식인지 확인합니다.
+func IsValidEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return false
+	}
+	return addr.Address == email
+}